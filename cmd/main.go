@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
 	"os"
@@ -18,9 +20,11 @@ import (
 	"time"
 
 	"nimbus/internal/api"
+	"nimbus/internal/artifact"
 	"nimbus/internal/config"
 	"nimbus/internal/env"
 	"nimbus/internal/logging"
+	"nimbus/internal/secrets"
 	"nimbus/internal/setup"
 
 	urllib "net/url"
@@ -57,11 +61,32 @@ func main() {
 				return fmt.Errorf("setting up database: %w", err)
 			}
 
-			return api.Start(port, &env.Env{
+			log.Info("setting up secret store", slog.String("backend", config.SecretsBackend))
+			store, err := setup.SecretStore(setupCtx, config, db)
+			if err != nil {
+				return fmt.Errorf("setting up secret store: %w", err)
+			}
+
+			env := &env.Env{
 				Logger:   log,
 				Database: db,
+				Secrets:  store,
 				Config:   config,
-			})
+			}
+
+			// Secrets were kept in kubernetes before the store existed. Deploys
+			// sync the store to kubernetes, so they must not run before.
+			log.Info("migrating secrets")
+			err = setup.MigrateSecrets(ctx, env)
+			if err != nil {
+				return fmt.Errorf("migrating secrets: %w", err)
+			}
+
+			// Unused volumes of branches which are not deployed again
+			const volumeReapInterval = time.Hour
+			go setup.ReapVolumes(ctx, env, volumeReapInterval)
+
+			return api.Start(port, env)
 		},
 	}
 	serverCmd.Flags().StringP("port", "p", "8080", "Port to run the server on")
@@ -106,6 +131,13 @@ func main() {
 				return err
 			}
 
+			artifactPath, _ := cmd.Flags().GetString("artifact")
+			if artifactPath != "" {
+				if err := writeArtifact(writer, artifactPath); err != nil {
+					return err
+				}
+			}
+
 			branchCmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
 			branchOutput, err := branchCmd.Output()
 			if err != nil {
@@ -164,6 +196,18 @@ func main() {
 
 			var out struct {
 				Services map[string][]string `json:"services"`
+				Volumes  []struct {
+					Service string `json:"service"`
+					Volume  string `json:"volume"`
+					From    int32  `json:"from"`
+					To      int32  `json:"to"`
+					Status  string `json:"status"`
+				} `json:"volumes"`
+				UnusedVolumes []struct {
+					Volume      string    `json:"volume"`
+					Status      string    `json:"status"`
+					DeleteAfter time.Time `json:"deleteAfter"`
+				} `json:"unusedVolumes"`
 			}
 			if err := json.Unmarshal(data, &out); err != nil {
 				return err
@@ -182,12 +226,35 @@ func main() {
 					}
 				}
 			}
+			if len(out.Volumes) > 0 {
+				fmt.Println("\nResized volumes:")
+				for _, v := range out.Volumes {
+					if v.Status == "unsupported" {
+						fmt.Printf("  %s/%s: %dMi -> %dMi not applied, the storage class does not allow expansion\n",
+							v.Service, v.Volume, v.From, v.To)
+						continue
+					}
+					fmt.Printf("  %s/%s: %dMi -> %dMi (%s)\n", v.Service, v.Volume, v.From, v.To, v.Status)
+				}
+			}
+			if len(out.UnusedVolumes) > 0 {
+				fmt.Println("\nVolumes removed from nimbus.yaml:")
+				for _, v := range out.UnusedVolumes {
+					if v.Status == "deleted" {
+						fmt.Printf("  %s: deleted\n", v.Volume)
+						continue
+					}
+					fmt.Printf("  %s: deleted by the first deploy after %s\n",
+						v.Volume, v.DeleteAfter.Local().Format(time.RFC1123))
+				}
+			}
 			return nil
 		},
 	}
 	deployCmd.Flags().StringP("host", "H", "", "Nimbus server host (default $NIMBUS_HOST)")
 	deployCmd.Flags().StringP("file", "f", "./nimbus.yaml", "Path to deployment file")
 	deployCmd.Flags().StringP("apikey", "a", "", "API key (default $NIMBUS_API_KEY)")
+	deployCmd.Flags().String("artifact", "", "Directory or .tar.gz served by static services")
 
 	projectCmd := &cobra.Command{Use: "projects", Short: "Manage projects"}
 	projectCreateCmd := &cobra.Command{
@@ -277,7 +344,73 @@ func main() {
 			return nil
 		},
 	}
-	projectCmd.AddCommand(projectCreateCmd, projectListCmd, projectDeleteCmd)
+	projectProtectCmd := &cobra.Command{
+		Use:   "protect [name]",
+		Short: "Protect preview branches with basic auth or an IP allowlist",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			username, _ := cmd.Flags().GetString("username")
+			password, _ := cmd.Flags().GetString("password")
+			allowlist, _ := cmd.Flags().GetStringSlice("allow")
+			disable, _ := cmd.Flags().GetBool("disable")
+
+			body := map[string]any{}
+			switch {
+			case disable:
+				body["mode"] = "none"
+			case username != "":
+				if password == "" {
+					fmt.Print("Password: ")
+					_, _ = fmt.Scanln(&password)
+				}
+				body["mode"] = "basic-auth"
+				body["username"] = username
+				body["password"] = password
+			case len(allowlist) > 0:
+				body["mode"] = "ip-allowlist"
+				body["allowlist"] = allowlist
+			default:
+				return fmt.Errorf("one of --username, --allow or --disable is required")
+			}
+
+			data, err := json.Marshal(body)
+			if err != nil {
+				return fmt.Errorf("marshaling body: %w", err)
+			}
+			url := fmt.Sprintf("%s/projects/%s/protection", host, args[0])
+			req, _ := http.NewRequest("PUT", url, bytes.NewBuffer(data))
+			req.Header.Set("Content-Type", "application/json")
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			if disable {
+				fmt.Println("Preview protection removed!")
+			} else {
+				fmt.Println("Preview protection updated!")
+			}
+			return nil
+		},
+	}
+	projectProtectCmd.Flags().String("username", "", "Basic auth username")
+	projectProtectCmd.Flags().String("password", "", "Basic auth password (prompted if omitted)")
+	projectProtectCmd.Flags().StringSlice("allow", nil, "IP address or CIDR range allowed to access previews")
+	projectProtectCmd.Flags().Bool("disable", false, "Remove the preview protection")
+	projectProtectCmd.MarkFlagsMutuallyExclusive("username", "allow", "disable")
+	projectProtectCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	projectProtectCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	projectCmd.AddCommand(projectCreateCmd, projectListCmd, projectDeleteCmd, projectProtectCmd)
 	projectCreateCmd.Flags().StringP("host", "H", "", "Nimbus host")
 	projectCreateCmd.Flags().StringP("apikey", "a", "", "API key")
 	projectListCmd.Flags().StringP("host", "H", "", "Nimbus host")
@@ -496,7 +629,11 @@ func main() {
 			host := getHost(cmd)
 			apiKey := getAPIKey(cmd)
 			project, _ := cmd.Flags().GetString("project")
+			branch, _ := cmd.Flags().GetString("branch")
 			url := fmt.Sprintf("%s/projects/%s/secrets", host, project)
+			if branch != "" {
+				url = fmt.Sprintf("%s?branch=%s", url, urllib.QueryEscape(branch))
+			}
 			req, _ := http.NewRequest("GET", url, nil)
 			if apiKey != "" {
 				req.Header.Set("X-API-Key", apiKey)
@@ -510,8 +647,11 @@ func main() {
 				data, _ := io.ReadAll(resp.Body)
 				return fmt.Errorf("failed: %s", string(data))
 			}
-			var out struct{ Secrets []string }
-			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil { //nolint:musttag
+			var out struct {
+				Secrets []string          `json:"secrets"`
+				Sources map[string]string `json:"sources"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
 				return err
 			}
 			fmt.Println("Secrets:")
@@ -520,12 +660,20 @@ func main() {
 				return nil
 			}
 			for _, n := range out.Secrets {
-				fmt.Printf("- %s\n", n)
+				switch out.Sources[n] {
+				case "default":
+					fmt.Printf("- %s (inherited)\n", n)
+				case "override":
+					fmt.Printf("- %s (overridden)\n", n)
+				default:
+					fmt.Printf("- %s\n", n)
+				}
 			}
 			return nil
 		},
 	}
 	secretsListCmd.Flags().String("project", "", "Project name")
+	secretsListCmd.Flags().String("branch", "", "Branch name, lists the project defaults when omitted")
 	secretsListCmd.Flags().StringP("host", "H", "", "Nimbus host")
 	secretsListCmd.Flags().StringP("apikey", "a", "", "API key")
 
@@ -539,7 +687,15 @@ func main() {
 			if project == "" {
 				return fmt.Errorf("project not specified")
 			}
-			url := fmt.Sprintf("%s/projects/%s/secrets?values=true", host, project)
+			branch, _ := cmd.Flags().GetString("branch")
+			secretsURL := fmt.Sprintf("%s/projects/%s/secrets", host, project)
+			if branch != "" {
+				secretsURL = fmt.Sprintf("%s?branch=%s", secretsURL, urllib.QueryEscape(branch))
+			}
+			url := secretsURL + "?values=true"
+			if branch != "" {
+				url = secretsURL + "&values=true"
+			}
 			req, _ := http.NewRequest("GET", url, nil)
 			if apiKey != "" {
 				req.Header.Set("X-API-Key", apiKey)
@@ -553,19 +709,42 @@ func main() {
 				data, _ := io.ReadAll(resp.Body)
 				return fmt.Errorf("failed: %s", string(data))
 			}
-			var out struct{ Secrets map[string]string }
-			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil { //nolint:musttag
+			var out struct {
+				Secrets map[string]string `json:"secrets"`
+				Sources map[string]string `json:"sources"`
+				Version int32             `json:"version"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
 				return err
 			}
+			// inherited secrets are commented out, uncommenting one overrides it
 			lines := make([]string, 0, len(out.Secrets))
+			inherited := make([]string, 0)
 			for k, v := range out.Secrets {
-				lines = append(lines, fmt.Sprintf("%s=%s", k, v))
+				if out.Sources[k] == "default" {
+					inherited = append(inherited, fmt.Sprintf("# %s=%s", k, secrets.QuoteDotenv(v)))
+					continue
+				}
+				lines = append(lines, fmt.Sprintf("%s=%s", k, secrets.QuoteDotenv(v)))
 			}
 			sort.Strings(lines)
-			line1 := "# Example: SECRET_NAME=value (use '=' not ':')"
-			line2 := "# DO NOT USE QUOTES IN SECRET VALUES UNLESS IT IS PART OF THE VALUE"
-			line3 := ""
-			lines = append([]string{line1, line2, line3}, lines...)
+			sort.Strings(inherited)
+			header := []string{
+				"# One secret per line in the .env format: SECRET_NAME=value",
+				`# Quote values with spaces, quotes or # as "a \"quoted\" value" or 'a literal value'`,
+				"",
+			}
+			if branch != "" {
+				header = append(header,
+					fmt.Sprintf("# Overrides of branch %s. Secrets inherited from the project defaults", branch),
+					"# are commented out below, uncomment a line to override it.",
+					"")
+			}
+			lines = append(header, lines...)
+			if len(inherited) > 0 {
+				lines = append(lines, "")
+				lines = append(lines, inherited...)
+			}
 			tmp, err := os.CreateTemp("", "nimbus-secrets-*.tmp")
 			if err != nil {
 				return err
@@ -590,26 +769,19 @@ func main() {
 			if err != nil {
 				return err
 			}
-			secrets := map[string]string{}
-			for _, line := range strings.Split(string(data), "\n") {
-				line = strings.TrimSpace(line)
-				if line == "" || strings.HasPrefix(line, "#") {
-					continue
-				}
-				const secretSections = 2
-				parts := strings.SplitN(line, "=", secretSections)
-				if len(parts) != secretSections {
-					continue
-				}
-				key := strings.TrimSpace(parts[0])
-				value := strings.TrimSpace(parts[1])
-				secrets[key] = value
+			values, err := secrets.ParseDotenv(string(data))
+			if err != nil {
+				return fmt.Errorf("parsing secrets: %w", err)
 			}
-			body, err := json.Marshal(map[string]map[string]string{"secrets": secrets})
+			body, err := json.Marshal(map[string]any{"secrets": values, "version": out.Version})
 			if err != nil {
 				return fmt.Errorf("marshaling body: %w", err)
 			}
-			req2, _ := http.NewRequest("PUT", fmt.Sprintf("%s/projects/%s/secrets", host, project), bytes.NewBuffer(body))
+			putURL := secretsURL
+			if noRestart, _ := cmd.Flags().GetBool("no-restart"); noRestart {
+				putURL = withQuery(putURL, "restart=false")
+			}
+			req2, _ := http.NewRequest("PUT", putURL, bytes.NewBuffer(body))
 			req2.Header.Set("Content-Type", "application/json")
 			if apiKey != "" {
 				req2.Header.Set("X-API-Key", apiKey)
@@ -619,18 +791,727 @@ func main() {
 				return err
 			}
 			defer func() { _ = resp2.Body.Close() }()
+			if resp2.StatusCode == http.StatusConflict {
+				return errors.New("secrets were changed by someone else while editing, run the command again")
+			}
 			if resp2.StatusCode != http.StatusOK {
 				data, _ := io.ReadAll(resp2.Body)
 				return fmt.Errorf("failed: %s", string(data))
 			}
+			var out2 struct {
+				Restarted []restartedServices `json:"restarted"`
+			}
+			if err := json.NewDecoder(resp2.Body).Decode(&out2); err != nil {
+				return err
+			}
 			fmt.Println("Secrets updated!")
+			printRestartedServices(out2.Restarted)
 			return nil
 		},
 	}
 	secretsEditCmd.Flags().String("project", "", "Project name")
+	secretsEditCmd.Flags().String("branch", "", "Branch name, edits the project defaults when omitted")
 	secretsEditCmd.Flags().StringP("host", "H", "", "Nimbus host")
 	secretsEditCmd.Flags().StringP("apikey", "a", "", "API key")
-	secretsCmd.AddCommand(secretsListCmd, secretsEditCmd)
+	secretsEditCmd.Flags().Bool("no-restart", false, "Don't restart the services reading changed secrets")
+
+	secretsSetCmd := &cobra.Command{
+		Use:   "set KEY=VALUE...",
+		Short: "Set secrets, leaving the others untouched",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			values := make(map[string]string, len(args))
+			for _, arg := range args {
+				key, value, found := strings.Cut(arg, "=")
+				if !found || key == "" {
+					return fmt.Errorf("invalid secret %q, expected KEY=VALUE", arg)
+				}
+				values[key] = value
+			}
+			return patchSecrets(cmd, map[string]any{"set": values})
+		},
+	}
+	secretsSetCmd.Flags().String("project", "", "Project name")
+	secretsSetCmd.Flags().String("branch", "", "Branch name, sets the project defaults when omitted")
+	secretsSetCmd.Flags().Bool("no-restart", false, "Don't restart the services reading changed secrets")
+	secretsSetCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	secretsSetCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	secretsUnsetCmd := &cobra.Command{
+		Use:   "unset KEY...",
+		Short: "Remove secrets, leaving the others untouched",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return patchSecrets(cmd, map[string]any{"unset": args})
+		},
+	}
+	secretsUnsetCmd.Flags().String("project", "", "Project name")
+	secretsUnsetCmd.Flags().String("branch", "", "Branch name, removes from the project defaults when omitted")
+	secretsUnsetCmd.Flags().Bool("no-restart", false, "Don't restart the services reading changed secrets")
+	secretsUnsetCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	secretsUnsetCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	secretsImportCmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Set the secrets of a .env file, - reads from stdin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var data []byte
+			var err error
+			if args[0] == "-" {
+				data, err = io.ReadAll(os.Stdin)
+			} else {
+				data, err = os.ReadFile(args[0])
+			}
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", args[0], err)
+			}
+			values, err := secrets.ParseDotenv(string(data))
+			if err != nil {
+				return fmt.Errorf("parsing %s: %w", args[0], err)
+			}
+			if len(values) == 0 {
+				return fmt.Errorf("no secrets found in %s", args[0])
+			}
+			return patchSecrets(cmd, map[string]any{"set": values})
+		},
+	}
+	secretsImportCmd.Flags().String("project", "", "Project name")
+	secretsImportCmd.Flags().String("branch", "", "Branch name, imports into the project defaults when omitted")
+	secretsImportCmd.Flags().Bool("no-restart", false, "Don't restart the services reading changed secrets")
+	secretsImportCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	secretsImportCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	secretsExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Print secrets as .env or JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			branch, _ := cmd.Flags().GetString("branch")
+			format, _ := cmd.Flags().GetString("format")
+			if format != "dotenv" && format != "json" {
+				return fmt.Errorf("unknown format %s, expected dotenv or json", format)
+			}
+			url := fmt.Sprintf("%s/projects/%s/secrets?values=true", host, project)
+			if branch != "" {
+				url = fmt.Sprintf("%s&branch=%s", url, urllib.QueryEscape(branch))
+			}
+			req, _ := http.NewRequest("GET", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				Secrets map[string]string `json:"secrets"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			if out.Secrets == nil {
+				out.Secrets = map[string]string{}
+			}
+			if format == "json" {
+				data, err := json.MarshalIndent(out.Secrets, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+				return nil
+			}
+			fmt.Print(secrets.FormatDotenv(out.Secrets))
+			return nil
+		},
+	}
+	secretsExportCmd.Flags().String("project", "", "Project name")
+	secretsExportCmd.Flags().String("branch", "",
+		"Branch name, exports the resolved secrets of the branch, the project defaults when omitted")
+	secretsExportCmd.Flags().String("format", "dotenv", "Output format, dotenv or json")
+	secretsExportCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	secretsExportCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	secretsHistoryCmd := &cobra.Command{
+		Use:   "history",
+		Short: "List secret versions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			branch, _ := cmd.Flags().GetString("branch")
+			url := fmt.Sprintf("%s/projects/%s/secrets/versions", host, project)
+			if branch != "" {
+				url = fmt.Sprintf("%s?branch=%s", url, urllib.QueryEscape(branch))
+			}
+			req, _ := http.NewRequest("GET", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				Versions []struct {
+					Version   int       `json:"version"`
+					User      string    `json:"user"`
+					Added     []string  `json:"added"`
+					Removed   []string  `json:"removed"`
+					Changed   []string  `json:"changed"`
+					CreatedAt time.Time `json:"createdAt"`
+				} `json:"versions"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			fmt.Println("Versions:")
+			if len(out.Versions) == 0 {
+				fmt.Println("No versions found")
+				return nil
+			}
+			for _, v := range out.Versions {
+				user := v.User
+				if user == "" {
+					user = "unknown"
+				}
+				keys := make([]string, 0, len(v.Added)+len(v.Removed)+len(v.Changed))
+				for _, k := range v.Added {
+					keys = append(keys, "+"+k)
+				}
+				for _, k := range v.Removed {
+					keys = append(keys, "-"+k)
+				}
+				for _, k := range v.Changed {
+					keys = append(keys, "~"+k)
+				}
+				fmt.Printf("- %d by %s at %s: %s\n",
+					v.Version, user, v.CreatedAt.Local().Format(time.DateTime), strings.Join(keys, " "))
+			}
+			return nil
+		},
+	}
+	secretsHistoryCmd.Flags().String("project", "", "Project name")
+	secretsHistoryCmd.Flags().String("branch", "", "Branch name, lists versions of the project defaults when omitted")
+	secretsHistoryCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	secretsHistoryCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	secretsRollbackCmd := &cobra.Command{
+		Use:   "rollback <version>",
+		Short: "Restore the secrets of a previous version",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			branch, _ := cmd.Flags().GetString("branch")
+			url := fmt.Sprintf("%s/projects/%s/secrets/versions/%s/rollback",
+				host, project, urllib.PathEscape(args[0]))
+			if branch != "" {
+				url = fmt.Sprintf("%s?branch=%s", url, urllib.QueryEscape(branch))
+			}
+			if noRestart, _ := cmd.Flags().GetBool("no-restart"); noRestart {
+				url = withQuery(url, "restart=false")
+			}
+			req, _ := http.NewRequest("POST", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				Version struct {
+					Version int `json:"version"`
+				} `json:"version"`
+				Restarted []restartedServices `json:"restarted"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			fmt.Printf("Secrets restored from version %s, now at version %d\n", args[0], out.Version.Version)
+			printRestartedServices(out.Restarted)
+			return nil
+		},
+	}
+	secretsRollbackCmd.Flags().String("project", "", "Project name")
+	secretsRollbackCmd.Flags().String("branch", "", "Branch name, rolls back the project defaults when omitted")
+	secretsRollbackCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	secretsRollbackCmd.Flags().StringP("apikey", "a", "", "API key")
+	secretsRollbackCmd.Flags().Bool("no-restart", false, "Don't restart the services reading changed secrets")
+	secretsCmd.AddCommand(secretsListCmd, secretsEditCmd, secretsSetCmd, secretsUnsetCmd,
+		secretsImportCmd, secretsExportCmd, secretsHistoryCmd, secretsRollbackCmd)
+
+	certsCmd := &cobra.Command{Use: "certs", Short: "Manage TLS certificates of custom domains"}
+	certsUploadCmd := &cobra.Command{
+		Use:   "upload",
+		Short: "Upload a certificate for a custom domain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			domain, _ := cmd.Flags().GetString("domain")
+			certPath, _ := cmd.Flags().GetString("cert")
+			keyPath, _ := cmd.Flags().GetString("key")
+			if project == "" || domain == "" || certPath == "" || keyPath == "" {
+				return fmt.Errorf("project, domain, cert and key are required")
+			}
+			cert, err := os.ReadFile(certPath)
+			if err != nil {
+				return fmt.Errorf("reading certificate: %w", err)
+			}
+			key, err := os.ReadFile(keyPath)
+			if err != nil {
+				return fmt.Errorf("reading key: %w", err)
+			}
+			body, err := json.Marshal(map[string]string{
+				"domain":      domain,
+				"certificate": string(cert),
+				"key":         string(key),
+			})
+			if err != nil {
+				return fmt.Errorf("marshaling body: %w", err)
+			}
+			url := fmt.Sprintf("%s/projects/%s/certificates", host, project)
+			req, _ := http.NewRequest("POST", url, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusCreated {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				Domain    string    `json:"domain"`
+				ExpiresAt time.Time `json:"expiresAt"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			fmt.Printf("Certificate for %s uploaded, expires %s\n", out.Domain, out.ExpiresAt.Format(time.DateOnly))
+			fmt.Println("Redeploy the main branch to serve it")
+			return nil
+		},
+	}
+	certsUploadCmd.Flags().String("project", "", "Project name")
+	certsUploadCmd.Flags().String("domain", "", "Custom domain covered by the certificate")
+	certsUploadCmd.Flags().String("cert", "", "Path to the PEM encoded certificate chain")
+	certsUploadCmd.Flags().String("key", "", "Path to the PEM encoded private key")
+	certsUploadCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	certsUploadCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	certsListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List uploaded certificates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			url := fmt.Sprintf("%s/projects/%s/certificates", host, project)
+			req, _ := http.NewRequest("GET", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				Certificates []struct {
+					Domain    string    `json:"domain"`
+					ExpiresAt time.Time `json:"expiresAt"`
+				} `json:"certificates"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			fmt.Println("Certificates:")
+			if len(out.Certificates) == 0 {
+				fmt.Println("No certificates found")
+				return nil
+			}
+			const expiryWarning = 30 * 24 * time.Hour
+			for _, c := range out.Certificates {
+				remaining := time.Until(c.ExpiresAt)
+				switch {
+				case remaining <= 0:
+					fmt.Printf("- %s (EXPIRED on %s)\n", c.Domain, c.ExpiresAt.Format(time.DateOnly))
+				case remaining < expiryWarning:
+					fmt.Printf("- %s (expires %s - WARNING: %d days left)\n",
+						c.Domain, c.ExpiresAt.Format(time.DateOnly), int(remaining.Hours()/24))
+				default:
+					fmt.Printf("- %s (expires %s)\n", c.Domain, c.ExpiresAt.Format(time.DateOnly))
+				}
+			}
+			return nil
+		},
+	}
+	certsListCmd.Flags().String("project", "", "Project name")
+	certsListCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	certsListCmd.Flags().StringP("apikey", "a", "", "API key")
+	certsCmd.AddCommand(certsUploadCmd, certsListCmd)
+
+	volumesCmd := &cobra.Command{Use: "volumes", Short: "Manage volumes"}
+	volumesListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List volumes of a branch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			branch, _ := cmd.Flags().GetString("branch")
+			if project == "" {
+				return fmt.Errorf("project is required")
+			}
+			url := fmt.Sprintf("%s/projects/%s/volumes?branch=%s", host, project, branch)
+			req, _ := http.NewRequest("GET", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				Volumes []struct {
+					Name        string     `json:"name"`
+					Identifier  string     `json:"identifier"`
+					Size        int32      `json:"size"`
+					Storage     string     `json:"storage"`
+					Capacity    string     `json:"capacity"`
+					Status      string     `json:"status"`
+					Services    []string   `json:"services"`
+					DeleteAfter *time.Time `json:"deleteAfter"`
+				} `json:"volumes"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			fmt.Println("Volumes:")
+			if len(out.Volumes) == 0 {
+				fmt.Println("No volumes found")
+				return nil
+			}
+			for _, v := range out.Volumes {
+				services := "unmounted"
+				if len(v.Services) > 0 {
+					services = "mounted by " + strings.Join(v.Services, ", ")
+				}
+				size := fmt.Sprintf("%dMi", v.Size)
+				if v.Capacity != "" {
+					size = fmt.Sprintf("%s (capacity %s)", size, v.Capacity)
+				}
+				if v.Storage != "" {
+					size = fmt.Sprintf("%s on %s", size, v.Storage)
+				}
+				if v.DeleteAfter != nil {
+					services = fmt.Sprintf("%s, removed from nimbus.yaml and deleted after %s",
+						services, v.DeleteAfter.Local().Format(time.RFC1123))
+				}
+				fmt.Printf("- %s: %s, %s, %s [%s]\n", v.Name, size, v.Status, services, v.Identifier)
+			}
+			return nil
+		},
+	}
+	volumesListCmd.Flags().String("project", "", "Project name")
+	volumesListCmd.Flags().String("branch", "main", "Branch name")
+	volumesListCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	volumesListCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	volumesDeleteCmd := &cobra.Command{
+		Use:   "delete [name]",
+		Short: "Delete an unmounted volume and its data",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			branch, _ := cmd.Flags().GetString("branch")
+			if project == "" {
+				return fmt.Errorf("project is required")
+			}
+			url := fmt.Sprintf("%s/projects/%s/volumes/%s?branch=%s", host, project, args[0], branch)
+			req, _ := http.NewRequest("DELETE", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusNoContent {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			fmt.Printf("Volume %s deleted\n", args[0])
+			return nil
+		},
+	}
+	volumesDeleteCmd.Flags().String("project", "", "Project name")
+	volumesDeleteCmd.Flags().String("branch", "main", "Branch name")
+	volumesDeleteCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	volumesDeleteCmd.Flags().StringP("apikey", "a", "", "API key")
+	volumesSnapshotCmd := &cobra.Command{
+		Use:   "snapshot [volume]",
+		Short: "Snapshot a volume",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			branch, _ := cmd.Flags().GetString("branch")
+			if project == "" {
+				return fmt.Errorf("project is required")
+			}
+			url := fmt.Sprintf("%s/projects/%s/volumes/%s/snapshots?branch=%s", host, project, args[0], branch)
+			req, _ := http.NewRequest("POST", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusCreated {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				ID     string `json:"id"`
+				Method string `json:"method"`
+				Ready  bool   `json:"ready"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			fmt.Printf("Snapshot %s of %s created (%s)\n", out.ID, args[0], out.Method)
+			if !out.Ready {
+				fmt.Println("The snapshot is being taken by the storage driver, check `nimbus volumes snapshots`")
+			}
+			return nil
+		},
+	}
+	volumesSnapshotCmd.Flags().String("project", "", "Project name")
+	volumesSnapshotCmd.Flags().String("branch", "main", "Branch name")
+	volumesSnapshotCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	volumesSnapshotCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	volumesSnapshotsCmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "List volume snapshots",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			if project == "" {
+				return fmt.Errorf("project is required")
+			}
+			url := fmt.Sprintf("%s/projects/%s/snapshots", host, project)
+			req, _ := http.NewRequest("GET", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				Snapshots []struct {
+					ID        string    `json:"id"`
+					Volume    string    `json:"volume"`
+					Branch    string    `json:"branch"`
+					Method    string    `json:"method"`
+					Size      int32     `json:"size"`
+					Ready     bool      `json:"ready"`
+					CreatedAt time.Time `json:"createdAt"`
+				} `json:"snapshots"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			fmt.Println("Snapshots:")
+			if len(out.Snapshots) == 0 {
+				fmt.Println("No snapshots found")
+				return nil
+			}
+			for _, s := range out.Snapshots {
+				status := "ready"
+				if !s.Ready {
+					status = "pending"
+				}
+				fmt.Printf("- %s: %s on %s, %dMi, %s, %s (%s)\n", s.ID, s.Volume, s.Branch, s.Size,
+					s.Method, s.CreatedAt.Format(time.DateTime), status)
+			}
+			return nil
+		},
+	}
+	volumesSnapshotsCmd.Flags().String("project", "", "Project name")
+	volumesSnapshotsCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	volumesSnapshotsCmd.Flags().StringP("apikey", "a", "", "API key")
+
+	volumesRestoreCmd := &cobra.Command{
+		Use:   "restore [snapshot]",
+		Short: "Restore a volume from a snapshot",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			if project == "" {
+				return fmt.Errorf("project is required")
+			}
+			url := fmt.Sprintf("%s/projects/%s/snapshots/%s/restore", host, project, args[0])
+			req, _ := http.NewRequest("POST", url, nil)
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusOK {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			var out struct {
+				Volume string `json:"volume"`
+				Branch string `json:"branch"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				return err
+			}
+			fmt.Printf("Volume %s on %s restored from snapshot %s\n", out.Volume, out.Branch, args[0])
+			return nil
+		},
+	}
+	volumesRestoreCmd.Flags().String("project", "", "Project name")
+	volumesRestoreCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	volumesRestoreCmd.Flags().StringP("apikey", "a", "", "API key")
+	volumesCpCmd := &cobra.Command{
+		Use:   "cp [source] [destination]",
+		Short: "Copy files between a volume and the local machine",
+		Long: "Copy files between a volume and the local machine. Volume paths are written as <volume>:<path>.\n" +
+			"  nimbus volumes cp data:/uploads ./uploads   downloads /uploads into ./uploads\n" +
+			"  nimbus volumes cp ./seed data:/seed         uploads ./seed into /seed",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host := getHost(cmd)
+			apiKey := getAPIKey(cmd)
+			project, _ := cmd.Flags().GetString("project")
+			branch, _ := cmd.Flags().GetString("branch")
+			if project == "" {
+				return fmt.Errorf("project is required")
+			}
+
+			srcVolume, srcPath, srcRemote := parseVolumePath(args[0])
+			dstVolume, dstPath, dstRemote := parseVolumePath(args[1])
+			if srcRemote == dstRemote {
+				return fmt.Errorf("exactly one of source and destination must be a volume path such as data:/path")
+			}
+
+			if srcRemote {
+				url := fmt.Sprintf("%s/projects/%s/volumes/%s/archive?branch=%s&path=%s",
+					host, project, srcVolume, branch, urllib.QueryEscape(srcPath))
+				req, _ := http.NewRequest("GET", url, nil)
+				if apiKey != "" {
+					req.Header.Set("X-API-Key", apiKey)
+				}
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					return err
+				}
+				defer func() { _ = resp.Body.Close() }()
+				if resp.StatusCode != http.StatusOK {
+					data, _ := io.ReadAll(resp.Body)
+					return fmt.Errorf("failed: %s", string(data))
+				}
+				if err := artifact.Extract(resp.Body, args[1]); err != nil {
+					return err
+				}
+				fmt.Printf("Copied %s to %s\n", args[0], args[1])
+				return nil
+			}
+
+			info, err := os.Stat(args[0])
+			if err != nil {
+				return fmt.Errorf("unable to open %s: %w", args[0], err)
+			}
+			reader, writer := io.Pipe()
+			go func() {
+				if info.IsDir() {
+					_ = writer.CloseWithError(artifact.Archive(args[0], writer))
+				} else {
+					_ = writer.CloseWithError(artifact.ArchiveFile(args[0], writer))
+				}
+			}()
+			url := fmt.Sprintf("%s/projects/%s/volumes/%s/archive?branch=%s&path=%s",
+				host, project, dstVolume, branch, urllib.QueryEscape(dstPath))
+			req, _ := http.NewRequest("PUT", url, reader)
+			req.Header.Set("Content-Type", "application/gzip")
+			if apiKey != "" {
+				req.Header.Set("X-API-Key", apiKey)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = resp.Body.Close() }()
+			if resp.StatusCode != http.StatusNoContent {
+				data, _ := io.ReadAll(resp.Body)
+				return fmt.Errorf("failed: %s", string(data))
+			}
+			fmt.Printf("Copied %s to %s\n", args[0], args[1])
+			return nil
+		},
+	}
+	volumesCpCmd.Flags().String("project", "", "Project name")
+	volumesCpCmd.Flags().String("branch", "main", "Branch name")
+	volumesCpCmd.Flags().StringP("host", "H", "", "Nimbus host")
+	volumesCpCmd.Flags().StringP("apikey", "a", "", "API key")
+	volumesCmd.AddCommand(volumesListCmd, volumesDeleteCmd, volumesSnapshotCmd, volumesSnapshotsCmd,
+		volumesRestoreCmd, volumesCpCmd)
 
 	branchCmd := &cobra.Command{Use: "branch", Short: "Manage branches"}
 	branchDeleteCmd := &cobra.Command{
@@ -669,7 +1550,7 @@ func main() {
 	branchDeleteCmd.Flags().StringP("apikey", "a", "", "API key")
 	branchCmd.AddCommand(branchDeleteCmd)
 
-	rootCmd.AddCommand(serverCmd, deployCmd, projectCmd, serviceCmd, branchCmd, secretsCmd)
+	rootCmd.AddCommand(serverCmd, deployCmd, projectCmd, serviceCmd, branchCmd, secretsCmd, certsCmd, volumesCmd)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -691,3 +1572,114 @@ func getAPIKey(cmd *cobra.Command) string {
 	}
 	return apiKey
 }
+
+// parseVolumePath splits a volume path such as data:/uploads into the
+// volume name and the path on the volume. Local paths are not volume paths.
+func parseVolumePath(arg string) (string, string, bool) {
+	volume, path, found := strings.Cut(arg, ":")
+	if !found || volume == "" || strings.ContainsAny(volume, `/\.`) {
+		return "", "", false
+	}
+	if path == "" {
+		path = "/"
+	}
+	return volume, path, true
+}
+
+// patchSecrets sends a patch of the secrets selected by the project and
+// branch flags of cmd and prints the outcome.
+func patchSecrets(cmd *cobra.Command, patch map[string]any) error {
+	host := getHost(cmd)
+	apiKey := getAPIKey(cmd)
+	project, _ := cmd.Flags().GetString("project")
+	if project == "" {
+		return fmt.Errorf("project not specified")
+	}
+	branch, _ := cmd.Flags().GetString("branch")
+	url := fmt.Sprintf("%s/projects/%s/secrets", host, project)
+	if branch != "" {
+		url = fmt.Sprintf("%s?branch=%s", url, urllib.QueryEscape(branch))
+	}
+	if noRestart, _ := cmd.Flags().GetBool("no-restart"); noRestart {
+		url = withQuery(url, "restart=false")
+	}
+
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("marshaling body: %w", err)
+	}
+	req, _ := http.NewRequest("PATCH", url, bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed: %s", string(data))
+	}
+	var out struct {
+		Version   int32               `json:"version"`
+		Restarted []restartedServices `json:"restarted"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return err
+	}
+	fmt.Printf("Secrets updated, now at version %d\n", out.Version)
+	printRestartedServices(out.Restarted)
+	return nil
+}
+
+// withQuery appends a query parameter such as restart=false to a URL.
+func withQuery(url, param string) string {
+	if strings.Contains(url, "?") {
+		return url + "&" + param
+	}
+	return url + "?" + param
+}
+
+// restartedServices are the services of a branch restarted after a secret
+// change.
+type restartedServices struct {
+	Branch   string   `json:"branch"`
+	Services []string `json:"services"`
+}
+
+func printRestartedServices(restarted []restartedServices) {
+	for _, r := range restarted {
+		fmt.Printf("Restarted in %s: %s\n", r.Branch, strings.Join(r.Services, ", "))
+	}
+}
+
+// writeArtifact adds the artifact at path to the deploy form. Directories
+// are packed into a gzipped tarball, files are uploaded as-is.
+func writeArtifact(writer *multipart.Writer, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("unable to open artifact %s: %w", path, err)
+	}
+
+	name := filepath.Base(path)
+	if info.IsDir() {
+		name += ".tar.gz"
+	}
+	part, err := writer.CreateFormFile("artifact", name)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		return artifact.Archive(path, part)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open artifact %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+	_, err = io.Copy(part, file)
+	return err
+}
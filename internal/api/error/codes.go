@@ -15,6 +15,14 @@ const (
 	ProjectNotFound         ErrorCode = "project_not_found"
 	DisabledBranchPreview   ErrorCode = "disabled_branch_preview"
 	ServiceNotFound         ErrorCode = "service_not_found"
+	DomainTaken             ErrorCode = "domain_taken"
+	NodePortUnavailable     ErrorCode = "node_port_unavailable"
+	VolumeNotFound          ErrorCode = "volume_not_found"
+	VolumeInUse             ErrorCode = "volume_in_use"
+	SnapshotNotFound        ErrorCode = "snapshot_not_found"
+	SnapshotNotReady        ErrorCode = "snapshot_not_ready"
+	SecretVersionNotFound   ErrorCode = "secret_version_not_found"
+	SecretsConflict         ErrorCode = "secrets_conflict"
 )
 
 var errorCodeToStatusCode = map[ErrorCode]int{
@@ -28,6 +36,14 @@ var errorCodeToStatusCode = map[ErrorCode]int{
 	ProjectNotFound:         http.StatusNotFound,
 	DisabledBranchPreview:   http.StatusConflict,
 	ServiceNotFound:         http.StatusNotFound,
+	DomainTaken:             http.StatusConflict,
+	NodePortUnavailable:     http.StatusConflict,
+	VolumeNotFound:          http.StatusNotFound,
+	VolumeInUse:             http.StatusConflict,
+	SnapshotNotFound:        http.StatusNotFound,
+	SnapshotNotReady:        http.StatusConflict,
+	SecretVersionNotFound:   http.StatusNotFound,
+	SecretsConflict:         http.StatusConflict,
 }
 
 func (ec ErrorCode) Status() int {
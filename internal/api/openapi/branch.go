@@ -5,15 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	apierror "nimbus/internal/api/error"
 	"nimbus/internal/api/requestid"
 	"nimbus/internal/database"
 	"nimbus/internal/env"
 	"nimbus/internal/kubernetes"
+	"nimbus/internal/postgres"
 	"nimbus/internal/utils"
 
 	"github.com/jackc/pgx/v5"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 func (Server) DeleteBranch(ctx context.Context, request DeleteBranchRequestObject) (DeleteBranchResponseObject, error) {
@@ -21,6 +24,17 @@ func (Server) DeleteBranch(ctx context.Context, request DeleteBranchRequestObjec
 	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
 	user := database.UserFromContext(ctx)
 
+	// The empty branch holds the project defaults and names starting with a
+	// colon are reserved for project wide secrets, none of them are branches
+	if request.Params.Branch == "" || strings.HasPrefix(request.Params.Branch, ":") {
+		return DeleteBranch400JSONResponse{
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			Message: fmt.Sprintf("invalid branch name %q", request.Params.Branch),
+			ErrorId: requestid,
+		}, nil
+	}
+
 	// Get project
 	env.Logger.DebugContext(ctx, "getting project")
 	project, err := env.Database.GetProjectByName(ctx, request.Params.Project)
@@ -89,6 +103,20 @@ func (Server) DeleteBranch(ctx context.Context, request DeleteBranchRequestObjec
 	// Delete resources
 	env.Logger.DebugContext(ctx, "deleting resources")
 	namespace := utils.GetSanitizedNamespace(project.Name, request.Params.Branch)
+	main, err := mainBranch(ctx, project.ID, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get main branch", slog.Any("error", err))
+		return DeleteBranch500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	mainNS := utils.GetSanitizedNamespace(project.Name, main)
+	if request.Params.Branch != main && namespace != mainNS {
+		dropSharedDatabases(ctx, mainNS, namespace, env)
+	}
 	for _, svc := range services {
 		err = kubernetes.DeleteDeployment(ctx, namespace, svc.ServiceName, env)
 		if err != nil {
@@ -104,6 +132,10 @@ func (Server) DeleteBranch(ctx context.Context, request DeleteBranchRequestObjec
 				env.Logger.ErrorContext(ctx, "failed to delete ingress", slog.Any("error", err))
 			}
 		}
+		err = kubernetes.DeleteConfigMap(ctx, namespace, kubernetes.StaticConfigMapName(svc.ServiceName), env)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			env.Logger.ErrorContext(ctx, "failed to delete config map", slog.Any("error", err))
+		}
 		err = env.Database.DeleteServiceById(ctx, svc.ID)
 		if err != nil {
 			env.Logger.ErrorContext(ctx, "failed to delete service", slog.Any("error", err))
@@ -116,6 +148,20 @@ func (Server) DeleteBranch(ctx context.Context, request DeleteBranchRequestObjec
 		}
 	}
 
+	err = env.Database.ReleaseBranchNodePorts(ctx, database.ReleaseBranchNodePortsParams{
+		ProjectID:     project.ID,
+		ProjectBranch: request.Params.Branch,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to release node ports", slog.Any("error", err))
+		return DeleteBranch500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
 	ids, err := env.Database.GetUnusedVolumeIdentifiers(
 		ctx,
 		database.GetUnusedVolumeIdentifiersParams{
@@ -154,6 +200,29 @@ func (Server) DeleteBranch(ctx context.Context, request DeleteBranchRequestObjec
 			ErrorId: requestid,
 		}, nil
 	}
+	err = env.Secrets.Delete(ctx, project.Name, request.Params.Branch)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to delete secrets", slog.Any("error", err))
+		return DeleteBranch500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	err = env.Database.DeleteSecretVersionsByBranch(ctx, database.DeleteSecretVersionsByBranchParams{
+		ProjectID:     project.ID,
+		ProjectBranch: request.Params.Branch,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to delete secret versions", slog.Any("error", err))
+		return DeleteBranch500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
 	err = kubernetes.DeleteNamespace(ctx, namespace, env)
 	if err != nil {
 		env.Logger.ErrorContext(ctx, "failed to delete namespace", slog.Any("error", err))
@@ -167,3 +236,43 @@ func (Server) DeleteBranch(ctx context.Context, request DeleteBranchRequestObjec
 
 	return DeleteBranch204Response{}, nil
 }
+
+// dropSharedDatabases drops the databases and roles provisioned for the
+// branch namespace inside the shared servers of the main namespace.
+func dropSharedDatabases(ctx context.Context, mainNS, namespace string, env *env.Env) {
+	conns, err := kubernetes.GetConnectionSecrets(ctx, namespace, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get connection secrets", slog.Any("error", err))
+		return
+	}
+	if len(conns) == 0 {
+		return
+	}
+
+	mainConns, err := kubernetes.GetConnectionSecrets(ctx, mainNS, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get main connection secrets", slog.Any("error", err))
+		return
+	}
+
+	for service, values := range conns {
+		env.Logger.DebugContext(ctx, "dropping shared database", slog.String("service", service))
+		conn, err := postgres.ConnectionFromValues(values)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to parse connection",
+				slog.String("service", service), slog.Any("error", err))
+			continue
+		}
+		admin, err := postgres.ConnectionFromValues(mainConns[service])
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to parse main connection",
+				slog.String("service", service), slog.Any("error", err))
+			continue
+		}
+		err = postgres.DropDatabase(ctx, admin, conn)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to drop shared database",
+				slog.String("service", service), slog.Any("error", err))
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	apierror "nimbus/internal/api/error"
+	"nimbus/internal/api/requestid"
+	"nimbus/internal/database"
+	"nimbus/internal/env"
+	"nimbus/internal/kubernetes"
+	"nimbus/internal/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func (Server) GetProjectsNameCertificates(
+	ctx context.Context, request GetProjectsNameCertificatesRequestObject,
+) (GetProjectsNameCertificatesResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameCertificates404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return GetProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return GetProjectsNameCertificates403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to view certificates",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get certificates
+	env.Logger.DebugContext(ctx, "getting certificates")
+	dbCertificates, err := env.Database.GetCertificatesByProject(ctx, project.ID)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get certificates", slog.Any("error", err))
+		return GetProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	certificates := make([]Certificate, len(dbCertificates))
+	for i, certificate := range dbCertificates {
+		certificates[i] = Certificate{
+			Domain:    certificate.Domain,
+			ExpiresAt: certificate.NotAfter.Time,
+		}
+	}
+
+	return GetProjectsNameCertificates200JSONResponse{
+		Certificates: &certificates,
+	}, nil
+}
+
+func (Server) PostProjectsNameCertificates(
+	ctx context.Context, request PostProjectsNameCertificatesRequestObject,
+) (PostProjectsNameCertificatesResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	if request.Body == nil {
+		return PostProjectsNameCertificates400JSONResponse{
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			Message: "request body is required",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PostProjectsNameCertificates404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PostProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return PostProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return PostProjectsNameCertificates403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to upload certificates",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Validate domain
+	domain := strings.ToLower(strings.TrimSuffix(request.Body.Domain, "."))
+	if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 || !strings.Contains(domain, ".") {
+		return PostProjectsNameCertificates422JSONResponse{
+			Status:  apierror.UnprocessibleContent.Status(),
+			Code:    apierror.UnprocessibleContent.String(),
+			Message: fmt.Sprintf("invalid domain %s", domain),
+			ErrorId: requestid,
+		}, nil
+	}
+
+	claimed, err := env.Database.GetDomain(ctx, domain)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get domain", slog.Any("error", err))
+		return PostProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	uploaded, certErr := env.Database.GetCertificate(ctx, domain)
+	if certErr != nil && !errors.Is(certErr, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get certificate", slog.Any("error", certErr))
+		return PostProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if (err == nil && claimed.ProjectID != project.ID) || (certErr == nil && uploaded.ProjectID != project.ID) {
+		env.Logger.DebugContext(ctx, "domain is claimed by another project", slog.String("domain", domain))
+		return PostProjectsNameCertificates409JSONResponse{
+			Status:  apierror.DomainTaken.Status(),
+			Code:    apierror.DomainTaken.String(),
+			Message: fmt.Sprintf("domain %s is already in use by another project", domain),
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Validate certificate
+	leaf, err := utils.ParseCertificate([]byte(request.Body.Certificate), []byte(request.Body.Key), domain)
+	if err != nil {
+		return PostProjectsNameCertificates422JSONResponse{
+			Status:  apierror.UnprocessibleContent.Status(),
+			Code:    apierror.UnprocessibleContent.String(),
+			Message: fmt.Sprintf("invalid certificate: %s", err),
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Claim the domain, the upsert leaves certificates of other projects
+	// untouched so concurrent uploads cannot take over a domain
+	env.Logger.DebugContext(ctx, "storing certificate", slog.String("domain", domain))
+	stored, err := env.Database.UpsertCertificate(ctx, database.UpsertCertificateParams{
+		Domain:     domain,
+		ProjectID:  project.ID,
+		SecretName: kubernetes.CertificateSecretName(domain),
+		NotAfter:   pgtype.Timestamptz{Time: leaf.NotAfter, Valid: true},
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to store certificate", slog.Any("error", err))
+		return PostProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if stored == 0 {
+		env.Logger.DebugContext(ctx, "domain is claimed by another project", slog.String("domain", domain))
+		return PostProjectsNameCertificates409JSONResponse{
+			Status:  apierror.DomainTaken.Status(),
+			Code:    apierror.DomainTaken.String(),
+			Message: fmt.Sprintf("domain %s is already in use by another project", domain),
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Store the certificate secret, custom domains are only served by the
+	// main branch
+	main, err := mainBranch(ctx, project.ID, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get main branch", slog.Any("error", err))
+		return PostProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	namespace := utils.GetSanitizedNamespace(project.Name, main)
+	env.Logger.DebugContext(ctx, "storing certificate secret",
+		slog.String("domain", domain),
+		slog.String("namespace", namespace))
+	err = kubernetes.UpdateCertificateSecret(ctx, namespace, domain,
+		[]byte(request.Body.Certificate), []byte(request.Body.Key), env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to store certificate secret", slog.Any("error", err))
+		return PostProjectsNameCertificates500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	return PostProjectsNameCertificates201JSONResponse{
+		Domain:    domain,
+		ExpiresAt: leaf.NotAfter,
+	}, nil
+}
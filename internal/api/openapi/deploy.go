@@ -6,14 +6,22 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 
 	apierror "nimbus/internal/api/error"
 	"nimbus/internal/api/requestid"
+	"nimbus/internal/artifact"
+	"nimbus/internal/config"
 	"nimbus/internal/database"
 	"nimbus/internal/env"
 	"nimbus/internal/kubernetes"
 	"nimbus/internal/models"
+	"nimbus/internal/postgres"
+	"nimbus/internal/secrets"
 	"nimbus/internal/utils"
 
 	"github.com/goccy/go-yaml"
@@ -21,6 +29,9 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 func shouldCreateKubeService(service *models.Service) bool {
@@ -28,13 +39,290 @@ func shouldCreateKubeService(service *models.Service) bool {
 		return true
 	}
 	switch service.Template {
-	case "postgres", "redis", "http":
+	case "postgres", "redis", "http", "static":
 		return true
 	default:
 		return false
 	}
 }
 
+// inlineSecretEnv returns the environment variables with the values of the
+// project secrets they reference.
+func inlineSecretEnv(vars []corev1.EnvVar, values map[string]string) []corev1.EnvVar {
+	out := make([]corev1.EnvVar, len(vars))
+	for i, variable := range vars {
+		out[i] = variable
+		if variable.ValueFrom != nil && variable.ValueFrom.SecretKeyRef != nil {
+			out[i].Value = values[variable.ValueFrom.SecretKeyRef.Key]
+		}
+	}
+	return out
+}
+
+var errSharedServerNotDeployed = errors.New("shared server is not deployed on main")
+
+// mainBranch returns the name of the main branch of a project, which is
+// master for projects deployed from master and main otherwise.
+func mainBranch(ctx context.Context, projectID uuid.UUID, env *env.Env) (string, error) {
+	branches, err := env.Database.GetProjectBranches(ctx, projectID)
+	if err != nil {
+		return "", fmt.Errorf("getting project branches: %w", err)
+	}
+	if slices.Contains(branches, "master") && !slices.Contains(branches, "main") {
+		return "master", nil
+	}
+	return "main", nil
+}
+
+// deploySharedServer publishes the connection details of a shared postgres
+// server. On main these are the superuser credentials of the server itself.
+// On other branches a separate database and role are provisioned inside
+// main's server, and the host of main's server is returned so that the
+// branch can point its service at it. The password of a branch role is kept
+// across deploys so running consumers stay connected.
+func deploySharedServer(
+	ctx context.Context, deployRequest *models.DeployRequest,
+	service *models.Service, env *env.Env,
+) (string, error) {
+	branch := deployRequest.BranchName
+	if branch != "main" && branch != "master" {
+		var err error
+		branch, err = mainBranch(ctx, deployRequest.ProjectID, env)
+		if err != nil {
+			return "", err
+		}
+	}
+	mainNS := utils.GetSanitizedNamespace(deployRequest.ProjectConfig.AppName, branch)
+	host := fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, mainNS)
+
+	if deployRequest.BranchName == "main" || deployRequest.BranchName == "master" {
+		admin := postgres.AdminConnection(host, inlineSecretEnv(service.Env, deployRequest.Secrets))
+		err := kubernetes.UpdateConnectionSecret(
+			ctx, deployRequest.Namespace, service.Name, admin.Values(), env)
+		if err != nil {
+			return "", fmt.Errorf("updating connection secret: %w", err)
+		}
+		return "", nil
+	}
+
+	secrets, err := kubernetes.GetConnectionSecrets(ctx, mainNS, env)
+	if err != nil {
+		return "", fmt.Errorf("getting main connection secrets: %w", err)
+	}
+	values, ok := secrets[service.Name]
+	if !ok {
+		return "", errSharedServerNotDeployed
+	}
+	admin, err := postgres.ConnectionFromValues(values)
+	if err != nil {
+		return "", fmt.Errorf("parsing main connection: %w", err)
+	}
+
+	conn := postgres.Connection{
+		Host:     host,
+		Port:     admin.Port,
+		Database: postgres.BranchIdentifier(admin.Database, deployRequest.BranchName),
+		User:     postgres.BranchIdentifier(admin.User, deployRequest.BranchName),
+	}
+	existing, err := kubernetes.GetConnectionSecrets(ctx, deployRequest.Namespace, env)
+	if err != nil {
+		return "", fmt.Errorf("getting connection secrets: %w", err)
+	}
+	if values, ok := existing[service.Name]; ok && values["PGUSER"] == conn.User {
+		conn.Password = values["PGPASSWORD"]
+	}
+	if conn.Password == "" {
+		conn.Password = kubernetes.GenerateRandomChars()
+	}
+	if err := postgres.CreateDatabase(ctx, admin, conn); err != nil {
+		return "", fmt.Errorf("creating database: %w", err)
+	}
+	err = kubernetes.UpdateConnectionSecret(
+		ctx, deployRequest.Namespace, service.Name, conn.Values(), env)
+	if err != nil {
+		return "", fmt.Errorf("updating connection secret: %w", err)
+	}
+
+	return host, nil
+}
+
+// deployArtifact replaces the content of the volume served by a static
+// service with the uploaded artifact.
+func deployArtifact(
+	ctx context.Context, deployRequest *models.DeployRequest,
+	service *models.Service, header *multipart.FileHeader, env *env.Env,
+) error {
+	var volumeName, subPath string
+	for _, volume := range service.Volumes {
+		if volume.MountPath == kubernetes.StaticRootPath {
+			volumeName = volume.Name
+			subPath = volume.SubPath
+		}
+	}
+	if volumeName == "" {
+		return fmt.Errorf("no volume mounted at %s", kubernetes.StaticRootPath)
+	}
+
+	identifier, err := env.Database.GetVolumeIdentifier(ctx, database.GetVolumeIdentifierParams{
+		VolumeName:    volumeName,
+		ProjectID:     deployRequest.ProjectID,
+		ProjectBranch: deployRequest.BranchName,
+	})
+	if err != nil {
+		return fmt.Errorf("getting volume identifier: %w", err)
+	}
+	path, err := kubernetes.GetVolumePath(
+		ctx, deployRequest.Namespace, fmt.Sprintf("pvc-%s", identifier), env)
+	if err != nil {
+		return fmt.Errorf("getting volume path: %w", err)
+	}
+	if subPath != "" {
+		path = filepath.Join(path, subPath)
+		if err := os.MkdirAll(path, 0o755); err != nil { //nolint:mnd
+			return fmt.Errorf("creating sub path: %w", err)
+		}
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return fmt.Errorf("opening artifact: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if err := artifact.Replace(file, path); err != nil {
+		return fmt.Errorf("replacing volume contents: %w", err)
+	}
+	return nil
+}
+
+var errNodePortUnavailable = errors.New("node port unavailable")
+
+// reserveNodePorts reserves a node port for each port of a public service in
+// the node port registry. Reservations are kept across deploys, requested
+// ports are honoured when free, and ports of services deployed before the
+// registry existed are adopted where possible. Reservations for ports no
+// longer exposed by the service are released.
+func reserveNodePorts(
+	ctx context.Context, deployRequest *models.DeployRequest,
+	service *models.Service, oldService *database.Service, env *env.Env,
+) ([]int32, error) {
+	lower, upper, err := config.ParsePortRange(env.Config.NodePortRange)
+	if err != nil {
+		return nil, fmt.Errorf("parsing node port range: %w", err)
+	}
+
+	reservations, err := env.Database.GetNodePortsByService(ctx, database.GetNodePortsByServiceParams{
+		ProjectID:     deployRequest.ProjectID,
+		ProjectBranch: deployRequest.BranchName,
+		ServiceName:   service.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting reserved node ports: %w", err)
+	}
+	reserved := make(map[string]int32, len(reservations))
+	for _, reservation := range reservations {
+		reserved[fmt.Sprintf("%d/%s", reservation.Port, reservation.Protocol)] = reservation.NodePort
+	}
+
+	allocatedList, err := env.Database.GetAllocatedNodePorts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting allocated node ports: %w", err)
+	}
+	allocated := make(map[int32]bool, len(allocatedList))
+	for _, nodePort := range allocatedList {
+		allocated[nodePort] = true
+	}
+	used, err := kubernetes.GetUsedNodePorts(
+		ctx, deployRequest.Namespace, kubernetes.PublicServiceName(service.Name), env)
+	if err != nil {
+		return nil, fmt.Errorf("getting used node ports: %w", err)
+	}
+	available := func(nodePort int32) bool {
+		return nodePort >= lower && nodePort <= upper && !allocated[nodePort] && !used[nodePort]
+	}
+
+	ports := kubernetes.PublicPorts(service)
+	nodePorts := make([]int32, len(ports))
+	keepPorts := make([]string, len(ports))
+	for idx, port := range ports {
+		requested := port.NodePort
+		keepPorts[idx] = fmt.Sprintf("%d/%s", port.Port, port.KubeProtocol())
+
+		current, hasReservation := reserved[keepPorts[idx]]
+		switch {
+		case requested != 0 && hasReservation && current == requested:
+			nodePorts[idx] = current
+		case requested != 0:
+			if !available(requested) {
+				return nil, fmt.Errorf("%w: %d is in use or outside of %s",
+					errNodePortUnavailable, requested, env.Config.NodePortRange)
+			}
+			nodePorts[idx] = requested
+		case hasReservation:
+			nodePorts[idx] = current
+		case oldService != nil && idx < len(oldService.NodePorts) && available(oldService.NodePorts[idx]):
+			nodePorts[idx] = oldService.NodePorts[idx]
+		default:
+			for candidate := lower; candidate <= upper; candidate++ {
+				if available(candidate) {
+					nodePorts[idx] = candidate
+					break
+				}
+			}
+			if nodePorts[idx] == 0 {
+				return nil, fmt.Errorf("%w: no free node ports left in %s",
+					errNodePortUnavailable, env.Config.NodePortRange)
+			}
+		}
+		allocated[nodePorts[idx]] = true
+
+		err = env.Database.ReserveNodePort(ctx, database.ReserveNodePortParams{
+			NodePort:      nodePorts[idx],
+			ProjectID:     deployRequest.ProjectID,
+			ProjectBranch: deployRequest.BranchName,
+			ServiceName:   service.Name,
+			Port:          port.Port,
+			Protocol:      string(port.KubeProtocol()),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reserving node port %d: %w", nodePorts[idx], err)
+		}
+	}
+
+	err = env.Database.ReleaseUnusedNodePorts(ctx, database.ReleaseUnusedNodePortsParams{
+		ProjectID:     deployRequest.ProjectID,
+		ProjectBranch: deployRequest.BranchName,
+		ServiceName:   service.Name,
+		KeepPorts:     keepPorts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("releasing node ports: %w", err)
+	}
+
+	return nodePorts, nil
+}
+
+// deletePublicService removes the node port service of a service and
+// releases its node ports.
+func deletePublicService(
+	ctx context.Context, deployRequest *models.DeployRequest, service string, env *env.Env,
+) error {
+	err := kubernetes.DeleteService(ctx, deployRequest.Namespace, kubernetes.PublicServiceName(service), env)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	err = env.Database.ReleaseUnusedNodePorts(ctx, database.ReleaseUnusedNodePortsParams{
+		ProjectID:     deployRequest.ProjectID,
+		ProjectBranch: deployRequest.BranchName,
+		ServiceName:   service,
+		KeepPorts:     []string{},
+	})
+	if err != nil {
+		return fmt.Errorf("releasing node ports: %w", err)
+	}
+	return nil
+}
+
 func (Server) PostDeploy(
 	ctx context.Context, request PostDeployRequestObject,
 ) (PostDeployResponseObject, error) {
@@ -106,6 +394,12 @@ func (Server) PostDeploy(
 		}, nil
 	}
 
+	var artifactHeader *multipart.FileHeader
+	if artifacts := form.File["artifact"]; len(artifacts) > 0 {
+		artifactHeader = artifacts[0]
+		env.Logger.DebugContext(ctx, "found artifact", slog.String("filename", artifactHeader.Filename))
+	}
+
 	env.Logger.DebugContext(ctx, "unmarshaling yaml", slog.String("filename", fileheader.Filename))
 	var config models.Config
 	err = yaml.Unmarshal(content, &config)
@@ -130,6 +424,19 @@ func (Server) PostDeploy(
 			ErrorId: requestID,
 		}, nil
 	}
+	if config.HostPattern != "" {
+		if err := utils.ValidateHostPattern(config.HostPattern); err != nil {
+			env.Logger.DebugContext(ctx, "invalid host pattern",
+				slog.String("pattern", config.HostPattern),
+				slog.Any("error", err))
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("invalid host pattern: %s", err),
+				ErrorId: requestID,
+			}, nil
+		}
+	}
 	if config.AllowBranchPreviews == nil {
 		v := true
 		config.AllowBranchPreviews = &v
@@ -217,80 +524,195 @@ func (Server) PostDeploy(
 		}, nil
 	}
 
-	// Get services
-	env.Logger.DebugContext(ctx, "getting project services",
-		slog.String("project", project.Name),
-		slog.String("branch", deployRequest.BranchName))
-	servicesList, err := env.Database.GetServicesByProject(ctx, database.GetServicesByProjectParams{
-		ProjectID:     deployRequest.ProjectID,
-		ProjectBranch: deployRequest.BranchName,
-	})
+	// Resolve shared volumes
+	err = config.ResolveVolumes()
 	if err != nil {
-		env.Logger.ErrorContext(ctx, "failed to get project services",
-			slog.String("project", project.Name),
-			slog.String("branch", deployRequest.BranchName),
-			slog.Any("error", err))
-		return PostDeploy500JSONResponse{
-			Status:  apierror.InternalServerError.Status(),
-			Code:    apierror.InternalServerError.String(),
-			Message: "Internal Server Error",
+		return PostDeploy422JSONResponse{
+			Status:  apierror.UnprocessibleContent.Status(),
+			Code:    apierror.UnprocessibleContent.String(),
+			Message: err.Error(),
 			ErrorId: requestID,
 		}, nil
 	}
-	deployRequest.ExistingServices = servicesList
+	for _, volume := range config.Volumes {
+		if _, ok := env.Config.StorageTier(volume.Storage); !ok {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("volume %s uses unknown storage tier %s", volume.Name, volume.Storage),
+				ErrorId: requestID,
+			}, nil
+		}
+	}
 
-	// Apply project secrets
-	env.Logger.DebugContext(ctx, "applying project secrets",
-		slog.String("project", project.Name))
-	secrets, err := kubernetes.GetSecretValues(ctx, project.Name, env)
+	// Validate volume tiers, read-write-once volumes can only be mounted by a
+	// single pod
+	volumeTiers := make(map[string]string)
+	volumeServices := make(map[string][]string)
+	for _, service := range config.Services {
+		for _, volume := range service.Volumes {
+			tier, ok := env.Config.StorageTier(volume.Storage)
+			if !ok {
+				return PostDeploy422JSONResponse{
+					Status: apierror.UnprocessibleContent.Status(),
+					Code:   apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("volume %s of service %s uses unknown storage tier %s",
+						volume.Name, service.Name, volume.Storage),
+					ErrorId: requestID,
+				}, nil
+			}
+			if storage, seen := volumeTiers[volume.Name]; seen && storage != volume.Storage {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("volume %s is declared with different storage tiers", volume.Name),
+					ErrorId: requestID,
+				}, nil
+			}
+			volumeTiers[volume.Name] = volume.Storage
+			if !slices.Contains(volumeServices[volume.Name], service.Name) {
+				volumeServices[volume.Name] = append(volumeServices[volume.Name], service.Name)
+			}
+			if !tier.ReadWriteOnce() {
+				continue
+			}
+			if len(volumeServices[volume.Name]) > 1 {
+				return PostDeploy422JSONResponse{
+					Status: apierror.UnprocessibleContent.Status(),
+					Code:   apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("volume %s uses the read-write-once storage tier %s "+
+						"and can only be mounted by a single service", volume.Name, volume.Storage),
+					ErrorId: requestID,
+				}, nil
+			}
+		}
+	}
+
+	// Validate the grace period of volumes removed from the config
+	volumeRetention, err := config.VolumeRetention()
 	if err != nil {
-		env.Logger.ErrorContext(ctx, "failed to get secret values",
-			slog.String("project", project.Name),
-			slog.Any("error", err))
-		return PostDeploy500JSONResponse{
-			Status:  apierror.InternalServerError.Status(),
-			Code:    apierror.InternalServerError.String(),
-			Message: "Internal Server Error",
+		return PostDeploy422JSONResponse{
+			Status:  apierror.UnprocessibleContent.Status(),
+			Code:    apierror.UnprocessibleContent.String(),
+			Message: err.Error(),
 			ErrorId: requestID,
 		}, nil
 	}
-	for i, service := range config.Services {
-		for j, variable := range service.Env {
-			key, prefFound := strings.CutPrefix(variable.Value, "${")
-			key, suffFound := strings.CutSuffix(key, "}")
-			if !prefFound || !suffFound {
+
+	// Validate volume sizes, volumes can grow but never shrink
+	for _, service := range config.Services {
+		for _, volume := range service.Volumes {
+			if volume.Size < 0 {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("volume %s of service %s has a negative size", volume.Name, service.Name),
+					ErrorId: requestID,
+				}, nil
+			}
+			existing, err := env.Database.GetVolume(ctx, database.GetVolumeParams{
+				VolumeName:    volume.Name,
+				ProjectID:     project.ID,
+				ProjectBranch: deployRequest.BranchName,
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
 				continue
 			}
-			if secretVal, ok := secrets[key]; ok {
-				config.Services[i].Env[j].Value = secretVal
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to get volume",
+					slog.String("volume", volume.Name),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+			if volume.Storage != existing.Storage {
+				return PostDeploy422JSONResponse{
+					Status: apierror.UnprocessibleContent.Status(),
+					Code:   apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("volume %s of service %s cannot move from storage tier %q to %q, "+
+						"delete it with `nimbus volumes delete` first", volume.Name, service.Name,
+						existing.Storage, volume.Storage),
+					ErrorId: requestID,
+				}, nil
+			}
+			if volume.RequestedSize() < existing.Size {
+				return PostDeploy422JSONResponse{
+					Status: apierror.UnprocessibleContent.Status(),
+					Code:   apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("volume %s of service %s cannot shrink from %dMi to %dMi",
+						volume.Name, service.Name, existing.Size, volume.RequestedSize()),
+					ErrorId: requestID,
+				}, nil
 			}
 		}
 	}
 
-	// Validate namespace
-	deployRequest.Namespace = utils.GetSanitizedNamespace(
-		project.Name, deployRequest.BranchName)
-	env.Logger.DebugContext(
-		ctx, "validating namespace", slog.String("namespace", deployRequest.Namespace))
-	created, err := kubernetes.ValidateNamespace(ctx, deployRequest.Namespace, env)
-	if err != nil {
-		env.Logger.ErrorContext(ctx, "failed to validate namespace",
-			slog.String("namespace", deployRequest.Namespace),
-			slog.Any("error", err))
-		return PostDeploy500JSONResponse{
-			Status:  apierror.InternalServerError.Status(),
-			Code:    apierror.InternalServerError.String(),
-			Message: "Internal Server Error",
-			ErrorId: requestID,
-		}, nil
+	// Validate shared servers
+	for _, service := range config.Services {
+		if !service.SharedServer {
+			continue
+		}
+		if service.Template != "postgres" {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s must use the postgres template to share its server", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		if service.SeedFrom != "" {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s cannot both share its server and be seeded", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
 	}
-	if created && deployRequest.BranchName != "main" && deployRequest.BranchName != "master" {
-		mainNS := utils.GetSanitizedNamespace(config.AppName, "main")
-		vals, err := kubernetes.GetSecretValues(ctx, mainNS, env)
-		if err != nil {
-			env.Logger.ErrorContext(ctx, "failed to get secret values",
-				slog.String("namespace", mainNS),
-				slog.String("source", "main"),
+
+	// Validate seed sources
+	for i, service := range config.Services {
+		if service.SeedFrom == "" {
+			continue
+		}
+		if service.SeedFrom != "main" {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s can only be seeded from main", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		if service.Template != "postgres" && service.Template != "redis" {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s must use the postgres or redis template to be seeded", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		if deployRequest.BranchName == "main" || deployRequest.BranchName == "master" {
+			config.Services[i].SeedFrom = ""
+			continue
+		}
+		_, err := env.Database.GetServiceByName(ctx, database.GetServiceByNameParams{
+			ServiceName:   service.Name,
+			ProjectID:     project.ID,
+			ProjectBranch: service.SeedFrom,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			env.Logger.WarnContext(ctx, "seed source service not found - skipping seed",
+				slog.String("service", service.Name),
+				slog.String("source", service.SeedFrom))
+			config.Services[i].SeedFrom = ""
+		} else if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to get seed source service",
+				slog.String("service", service.Name),
+				slog.String("source", service.SeedFrom),
 				slog.Any("error", err))
 			return PostDeploy500JSONResponse{
 				Status:  apierror.InternalServerError.Status(),
@@ -299,14 +721,657 @@ func (Server) PostDeploy(
 				ErrorId: requestID,
 			}, nil
 		}
-		if len(vals) > 0 {
-			err = kubernetes.UpdateSecret(
-				ctx, deployRequest.Namespace, fmt.Sprintf("%s-env", config.AppName),
-				vals, env)
+	}
+
+	// Validate cross-branch references
+	for i, service := range config.Services {
+		if service.From == "" {
+			continue
+		}
+		if service.From != "main" {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s can only reference main", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		if service.SharedServer || service.SeedFrom != "" {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s cannot reference main and share or seed its server", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		// main deploys the referenced service itself
+		if deployRequest.BranchName == "main" || deployRequest.BranchName == "master" {
+			config.Services[i].From = ""
+			continue
+		}
+		found := false
+		for _, branch := range []string{"main", "master"} {
+			_, err := env.Database.GetServiceByName(ctx, database.GetServiceByNameParams{
+				ServiceName:   service.Name,
+				ProjectID:     project.ID,
+				ProjectBranch: branch,
+			})
+			if err == nil {
+				// the host points at the branch the service is deployed on
+				config.Services[i].From = branch
+				found = true
+				break
+			}
+			if !errors.Is(err, pgx.ErrNoRows) {
+				env.Logger.ErrorContext(ctx, "failed to get referenced service",
+					slog.String("service", service.Name),
+					slog.String("branch", branch),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+		}
+		if !found {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s must be deployed on main before it can be referenced", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+	}
+
+	// Validate allowed projects
+	allowedProjects := make(map[string]uuid.UUID, len(config.AllowFrom))
+	for _, allow := range config.AllowFrom {
+		if allow.Project == "" {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: "allowFrom entries must name a project",
+				ErrorId: requestID,
+			}, nil
+		}
+		allowed, err := env.Database.GetProjectByName(ctx, allow.Project)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("allowFrom project %s does not exist", allow.Project),
+				ErrorId: requestID,
+			}, nil
+		}
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to get allowed project",
+				slog.String("project", allow.Project),
+				slog.Any("error", err))
+			return PostDeploy500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestID,
+			}, nil
+		}
+		allowedProjects[allow.Project] = allowed.ID
+	}
+
+	// Validate ports and routes
+	servicesByName := make(map[string]*models.Service, len(config.Services))
+	for i := range config.Services {
+		if _, ok := servicesByName[config.Services[i].Name]; ok {
+			env.Logger.ErrorContext(ctx, "duplicate service name", slog.String("service", config.Services[i].Name))
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: "service names must be unique",
+				ErrorId: requestID,
+			}, nil
+		}
+		servicesByName[config.Services[i].Name] = &config.Services[i]
+	}
+	for i, service := range config.Services {
+		if err := config.Services[i].Network.ApplyNodePorts(); err != nil {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s has invalid node ports: %s", service.Name, err),
+				ErrorId: requestID,
+			}, nil
+		}
+		service := config.Services[i]
+		seenPorts := make(map[string]bool)
+		for idx, port := range service.Network.Ports {
+			if err := port.Validate(); err != nil {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("service %s has an invalid port: %s", service.Name, err),
+					ErrorId: requestID,
+				}, nil
+			}
+			key := fmt.Sprintf("%d/%s", port.Port, port.KubeProtocol())
+			if seenPorts[key] || seenPorts[port.PortName(idx)] {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("service %s has duplicate port %s", service.Name, port.PortName(idx)),
+					ErrorId: requestID,
+				}, nil
+			}
+			seenPorts[key] = true
+			seenPorts[port.PortName(idx)] = true
+			if port.NodePort != 0 && !service.IsPublic(port) {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("service %s can only request node ports for its public ports", service.Name),
+					ErrorId: requestID,
+				}, nil
+			}
+		}
+		if service.Network.HTTPPort != 0 && !slices.ContainsFunc(service.Network.Ports,
+			func(port models.Port) bool { return port.Port == service.Network.HTTPPort }) {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s must list its http port in ports", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		if len(service.Routes) == 0 {
+			continue
+		}
+		if !service.ServesHTTP() {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s must be an http service to define routes", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		seenPaths := make(map[string]bool)
+		for j, route := range service.Routes {
+			if !strings.HasPrefix(route.Path, "/") || seenPaths[route.Path] {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("service %s has invalid or duplicate route path %q", service.Name, route.Path),
+					ErrorId: requestID,
+				}, nil
+			}
+			seenPaths[route.Path] = true
+			if route.Service == "" {
+				route.Service = service.Name
+			}
+			target, ok := servicesByName[route.Service]
+			if !ok {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("route %s targets unknown service %s", route.Path, route.Service),
+					ErrorId: requestID,
+				}, nil
+			}
+			if route.Port == 0 {
+				route.Port = target.HTTPPort()
+			}
+			if !target.ExposesPort(route.Port) {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("route %s targets unexposed port %d of %s", route.Path, route.Port, route.Service),
+					ErrorId: requestID,
+				}, nil
+			}
+			config.Services[i].Routes[j] = route
+		}
+	}
+
+	// Validate ingress options
+	for _, service := range config.Services {
+		if service.Ingress == nil {
+			continue
+		}
+		if !service.ServesHTTP() {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s must be an http service to set ingress options", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		if err := service.Ingress.Validate(); err != nil {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s has invalid ingress options: %s", service.Name, err),
+				ErrorId: requestID,
+			}, nil
+		}
+	}
+
+	// Validate custom domains
+	isMain := deployRequest.BranchName == "main" || deployRequest.BranchName == "master"
+	seenDomains := make(map[string]bool)
+	for i, service := range config.Services {
+		if len(service.Domains) == 0 {
+			continue
+		}
+		if !service.ServesHTTP() || !service.Public {
+			return PostDeploy422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("service %s must be a public http service to use custom domains", service.Name),
+				ErrorId: requestID,
+			}, nil
+		}
+		for j, domain := range service.Domains {
+			domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+			if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 ||
+				!strings.Contains(domain, ".") {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("service %s has invalid domain %s", service.Name, domain),
+					ErrorId: requestID,
+				}, nil
+			}
+			if seenDomains[domain] {
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("domain %s is used more than once", domain),
+					ErrorId: requestID,
+				}, nil
+			}
+			seenDomains[domain] = true
+			config.Services[i].Domains[j] = domain
+		}
+		// previews keep their generated host only
+		if !isMain {
+			config.Services[i].Domains = nil
+		}
+	}
+
+	// Claim custom domains and release removed ones, only once the whole
+	// config is valid so rejected deploys leave the claims untouched
+	if isMain {
+		keepDomains := make([]string, 0, len(seenDomains))
+		for _, service := range config.Services {
+			for _, domain := range service.Domains {
+				// Claims of other projects are left untouched and affect no rows
+				claimed, err := env.Database.UpsertDomain(ctx, database.UpsertDomainParams{
+					Domain:      domain,
+					ProjectID:   project.ID,
+					ServiceName: service.Name,
+				})
+				if err != nil {
+					env.Logger.ErrorContext(ctx, "failed to claim domain",
+						slog.String("domain", domain),
+						slog.Any("error", err))
+					return PostDeploy500JSONResponse{
+						Status:  apierror.InternalServerError.Status(),
+						Code:    apierror.InternalServerError.String(),
+						Message: "Internal Server Error",
+						ErrorId: requestID,
+					}, nil
+				}
+				if claimed == 0 {
+					env.Logger.DebugContext(ctx, "domain is claimed by another project",
+						slog.String("domain", domain),
+						slog.String("project", project.Name))
+					return PostDeploy409JSONResponse{
+						Status:  apierror.DomainTaken.Status(),
+						Code:    apierror.DomainTaken.String(),
+						Message: fmt.Sprintf("domain %s is already in use by another project", domain),
+						ErrorId: requestID,
+					}, nil
+				}
+				keepDomains = append(keepDomains, domain)
+			}
+		}
+		err = env.Database.DeleteUnusedDomains(ctx, database.DeleteUnusedDomainsParams{
+			ProjectID:   project.ID,
+			KeepDomains: keepDomains,
+		})
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to release unused domains",
+				slog.String("project", project.Name),
+				slog.Any("error", err))
+			return PostDeploy500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestID,
+			}, nil
+		}
+	}
+	deployRequest.ProjectConfig = config
+
+	// Get services
+	env.Logger.DebugContext(ctx, "getting project services",
+		slog.String("project", project.Name),
+		slog.String("branch", deployRequest.BranchName))
+	servicesList, err := env.Database.GetServicesByProject(ctx, database.GetServicesByProjectParams{
+		ProjectID:     deployRequest.ProjectID,
+		ProjectBranch: deployRequest.BranchName,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project services",
+			slog.String("project", project.Name),
+			slog.String("branch", deployRequest.BranchName),
+			slog.Any("error", err))
+		return PostDeploy500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestID,
+		}, nil
+	}
+	deployRequest.ExistingServices = servicesList
+
+	// Apply project secrets, the project defaults with the branch overrides.
+	// Services reference them in the env secret rather than copying values.
+	env.Logger.DebugContext(ctx, "applying project secrets",
+		slog.String("project", project.Name),
+		slog.String("branch", deployRequest.BranchName))
+	secretValues, _, err := secrets.Resolve(ctx, env.Secrets, project.Name, deployRequest.BranchName)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get secret values",
+			slog.String("project", project.Name),
+			slog.Any("error", err))
+		return PostDeploy500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestID,
+		}, nil
+	}
+	for i, service := range config.Services {
+		config.Services[i].Env = kubernetes.SecretEnv(project.Name, service.Env, secretValues)
+	}
+	deployRequest.Secrets = secretValues
+
+	// Validate namespace
+	deployRequest.Namespace = utils.GetSanitizedNamespace(
+		project.Name, deployRequest.BranchName)
+	env.Logger.DebugContext(
+		ctx, "validating namespace", slog.String("namespace", deployRequest.Namespace))
+	_, err = kubernetes.ValidateNamespace(ctx, deployRequest.Namespace, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to validate namespace",
+			slog.String("namespace", deployRequest.Namespace),
+			slog.Any("error", err))
+		return PostDeploy500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestID,
+		}, nil
+	}
+	// Sync the resolved secrets to the branch
+	env.Logger.DebugContext(ctx, "syncing secrets",
+		slog.String("namespace", deployRequest.Namespace))
+	_, err = kubernetes.SyncSecrets(ctx, project.Name, deployRequest.BranchName, secretValues, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to sync secrets",
+			slog.String("namespace", deployRequest.Namespace),
+			slog.Any("error", err))
+		return PostDeploy500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestID,
+		}, nil
+	}
+
+	// Isolate namespace
+	err = kubernetes.LabelNamespace(ctx, deployRequest.Namespace,
+		kubernetes.NamespaceLabels(project.ID, deployRequest.BranchName), env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to label namespace",
+			slog.String("namespace", deployRequest.Namespace),
+			slog.Any("error", err))
+		return PostDeploy500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestID,
+		}, nil
+	}
+	if env.Config.NetworkPoliciesEnabled() {
+		env.Logger.DebugContext(ctx, "applying network policies",
+			slog.String("namespace", deployRequest.Namespace))
+		policies := kubernetes.GenerateNetworkPolicies(
+			deployRequest.Namespace, &config, project.ID, allowedProjects, isMain, env)
+		err = kubernetes.ApplyNetworkPolicies(ctx, deployRequest.Namespace, policies, env)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to apply network policies",
+				slog.String("namespace", deployRequest.Namespace),
+				slog.Any("error", err))
+			return PostDeploy500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestID,
+			}, nil
+		}
+	}
+
+	// Protect preview ingresses
+	if !isMain {
+		protection, err := env.Database.GetPreviewProtection(ctx, project.ID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			env.Logger.ErrorContext(ctx, "failed to get preview protection",
+				slog.String("project", project.Name),
+				slog.Any("error", err))
+			return PostDeploy500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestID,
+			}, nil
+		}
+		if err == nil {
+			deployRequest.PreviewProtection = &models.PreviewProtection{
+				Mode:      protection.Mode,
+				Allowlist: protection.Allowlist,
+			}
+		}
+		err = syncPreviewAuth(ctx, project.Name, deployRequest.Namespace, deployRequest.PreviewProtection, env)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to sync preview auth secret",
+				slog.String("namespace", deployRequest.Namespace),
+				slog.Any("error", err))
+			return PostDeploy500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestID,
+			}, nil
+		}
+	}
+
+	// Delete removed services
+	existingServices := make(map[string]*database.Service)
+	for _, service := range deployRequest.ExistingServices {
+		existingServices[service.ServiceName] = &service
+	}
+	env.Logger.DebugContext(ctx, "deleting services not present in config",
+		slog.String("project", project.Name),
+		slog.String("branch", deployRequest.BranchName))
+	serviceNames := make(map[string]bool)
+	for _, service := range config.Services {
+		serviceNames[service.Name] = true
+	}
+
+	for _, service := range existingServices {
+		if _, ok := serviceNames[service.ServiceName]; !ok {
+			env.Logger.DebugContext(
+				ctx, "deleting deployment",
+				slog.String("service", service.ServiceName),
+				slog.String("namespace", deployRequest.Namespace))
+			err := kubernetes.DeleteDeployment(
+				ctx, deployRequest.Namespace, service.ServiceName, env)
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to delete deployment",
+					slog.String("service", service.ServiceName),
+					slog.String("namespace", deployRequest.Namespace),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+
+			env.Logger.DebugContext(ctx, "deleting service",
+				slog.String("service", service.ServiceName),
+				slog.String("namespace", deployRequest.Namespace))
+			err = kubernetes.DeleteService(ctx, deployRequest.Namespace, service.ServiceName, env)
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to delete service",
+					slog.String("service", service.ServiceName),
+					slog.String("namespace", deployRequest.Namespace),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+
+			if service.Ingress.Valid {
+				env.Logger.DebugContext(ctx, "deleting ingress",
+					slog.String("service", service.ServiceName),
+					slog.String("ingress", service.Ingress.String))
+				err = kubernetes.DeleteIngress(ctx, deployRequest.Namespace, service.Ingress.String, env)
+				if err != nil {
+					env.Logger.ErrorContext(ctx, "failed to delete ingress",
+						slog.String("service", service.ServiceName),
+						slog.String("ingress", service.Ingress.String),
+						slog.Any("error", err))
+					return PostDeploy500JSONResponse{
+						Status:  apierror.InternalServerError.Status(),
+						Code:    apierror.InternalServerError.String(),
+						Message: "Internal Server Error",
+						ErrorId: requestID,
+					}, nil
+				}
+				err = kubernetes.DeleteIngressByName(ctx, deployRequest.Namespace,
+					kubernetes.CertificateIngressName(service.ServiceName), env)
+				if err != nil {
+					env.Logger.ErrorContext(ctx, "failed to delete certificate ingress",
+						slog.String("service", service.ServiceName),
+						slog.Any("error", err))
+					return PostDeploy500JSONResponse{
+						Status:  apierror.InternalServerError.Status(),
+						Code:    apierror.InternalServerError.String(),
+						Message: "Internal Server Error",
+						ErrorId: requestID,
+					}, nil
+				}
+			}
+
+			env.Logger.DebugContext(ctx, "deleting static config map",
+				slog.String("service", service.ServiceName))
+			err = kubernetes.DeleteConfigMap(ctx, deployRequest.Namespace,
+				kubernetes.StaticConfigMapName(service.ServiceName), env)
+			if err != nil && !k8serrors.IsNotFound(err) {
+				env.Logger.ErrorContext(ctx, "failed to delete static config map",
+					slog.String("service", service.ServiceName),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+
+			env.Logger.DebugContext(ctx, "deleting public service",
+				slog.String("service", service.ServiceName))
+			err = deletePublicService(ctx, &deployRequest, service.ServiceName, env)
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to delete public service",
+					slog.String("service", service.ServiceName),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+
+			env.Logger.DebugContext(ctx, "deleting service in database",
+				slog.String("service", service.ServiceName))
+			err = env.Database.DeleteServiceById(ctx, service.ID)
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to delete service",
+					slog.String("service", service.ServiceName),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+		}
+	}
+
+	// Uploaded certificates replace the issuer for custom domains on main
+	certificates := make(map[string]string)
+	if isMain {
+		uploaded, err := env.Database.GetCertificatesByProject(ctx, project.ID)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to get certificates",
+				slog.String("project", project.Name),
+				slog.Any("error", err))
+			return PostDeploy500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestID,
+			}, nil
+		}
+		for _, certificate := range uploaded {
+			certificates[certificate.Domain] = certificate.SecretName
+		}
+	}
+
+	env.Logger.DebugContext(ctx, "creating services and deployments",
+		slog.String("project", project.Name),
+		slog.String("branch", deployRequest.BranchName))
+	serviceUrls := make(map[string][]string)
+	for _, serviceConfig := range config.Services {
+
+		// Provision shared database server
+		var sharedHost string
+		if serviceConfig.SharedServer {
+			env.Logger.DebugContext(ctx, "deploying shared database server",
+				slog.String("service", serviceConfig.Name))
+			sharedHost, err = deploySharedServer(ctx, &deployRequest, &serviceConfig, env)
+			if errors.Is(err, errSharedServerNotDeployed) {
+				env.Logger.ErrorContext(ctx, "shared database server not deployed on main",
+					slog.String("service", serviceConfig.Name))
+				return PostDeploy422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: fmt.Sprintf("service %s must be deployed on main before it can be shared", serviceConfig.Name),
+					ErrorId: requestID,
+				}, nil
+			}
 			if err != nil {
-				env.Logger.ErrorContext(ctx, "failed to update secrets",
-					slog.String("namespace", deployRequest.Namespace),
-					slog.String("app", config.AppName),
+				env.Logger.ErrorContext(ctx, "failed to deploy shared database server",
+					slog.String("service", serviceConfig.Name),
 					slog.Any("error", err))
 				return PostDeploy500JSONResponse{
 					Status:  apierror.InternalServerError.Status(),
@@ -316,42 +1381,20 @@ func (Server) PostDeploy(
 				}, nil
 			}
 		}
-	}
 
-	// Delete removed services
-	existingServices := make(map[string]*database.Service)
-	for _, service := range deployRequest.ExistingServices {
-		existingServices[service.ServiceName] = &service
-	}
-	env.Logger.DebugContext(ctx, "deleting services not present in config",
-		slog.String("project", project.Name),
-		slog.String("branch", deployRequest.BranchName))
-	serviceNames := make(map[string]bool)
-	for _, service := range config.Services {
-		if serviceNames[service.Name] {
-			env.Logger.ErrorContext(ctx, "duplicate service name", slog.String("service", service.Name))
-			return PostDeploy422JSONResponse{
-				Status:  apierror.UnprocessibleContent.Status(),
-				Code:    apierror.UnprocessibleContent.String(),
-				Message: "service names must be unique",
-				ErrorId: requestID,
-			}, nil
+		// Reference main's service
+		if serviceConfig.From != "" {
+			mainNS := utils.GetSanitizedNamespace(config.AppName, serviceConfig.From)
+			sharedHost = fmt.Sprintf("%s.%s.svc.cluster.local", serviceConfig.Name, mainNS)
 		}
-		serviceNames[service.Name] = true
-	}
 
-	for _, service := range existingServices {
-		if _, ok := serviceNames[service.ServiceName]; !ok {
-			env.Logger.DebugContext(
-				ctx, "deleting deployment",
-				slog.String("service", service.ServiceName),
-				slog.String("namespace", deployRequest.Namespace))
-			err := kubernetes.DeleteDeployment(
-				ctx, deployRequest.Namespace, service.ServiceName, env)
-			if err != nil {
+		if sharedHost != "" {
+			// the branch uses main's service - remove any previously deployed copy
+			serviceConfig.Public = false
+			err = kubernetes.DeleteDeployment(ctx, deployRequest.Namespace, serviceConfig.Name, env)
+			if err != nil && !k8serrors.IsNotFound(err) {
 				env.Logger.ErrorContext(ctx, "failed to delete deployment",
-					slog.String("service", service.ServiceName),
-					slog.String("namespace", deployRequest.Namespace),
+					slog.String("service", serviceConfig.Name),
 					slog.Any("error", err))
 				return PostDeploy500JSONResponse{
 					Status:  apierror.InternalServerError.Status(),
@@ -360,15 +1403,15 @@ func (Server) PostDeploy(
 					ErrorId: requestID,
 				}, nil
 			}
-
-			env.Logger.DebugContext(ctx, "deleting service",
-				slog.String("service", service.ServiceName),
-				slog.String("namespace", deployRequest.Namespace))
-			err = kubernetes.DeleteService(ctx, deployRequest.Namespace, service.ServiceName, env)
+		} else {
+			// Create deployment
+			env.Logger.DebugContext(ctx, "creating deployment",
+				slog.String("service", serviceConfig.Name))
+			deploymentSpec, err := kubernetes.GenerateDeploymentSpec(
+				ctx, &deployRequest, &serviceConfig, env)
 			if err != nil {
-				env.Logger.ErrorContext(ctx, "failed to delete service",
-					slog.String("service", service.ServiceName),
-					slog.String("namespace", deployRequest.Namespace),
+				env.Logger.ErrorContext(ctx, "failed to create deployment",
+					slog.String("service", serviceConfig.Name),
 					slog.Any("error", err))
 				return PostDeploy500JSONResponse{
 					Status:  apierror.InternalServerError.Status(),
@@ -377,32 +1420,10 @@ func (Server) PostDeploy(
 					ErrorId: requestID,
 				}, nil
 			}
-
-			if service.Ingress.Valid {
-				env.Logger.DebugContext(ctx, "deleting ingress",
-					slog.String("service", service.ServiceName),
-					slog.String("ingress", service.Ingress.String))
-				err = kubernetes.DeleteIngress(ctx, deployRequest.Namespace, service.Ingress.String, env)
-				if err != nil {
-					env.Logger.ErrorContext(ctx, "failed to delete ingress",
-						slog.String("service", service.ServiceName),
-						slog.String("ingress", service.Ingress.String),
-						slog.Any("error", err))
-					return PostDeploy500JSONResponse{
-						Status:  apierror.InternalServerError.Status(),
-						Code:    apierror.InternalServerError.String(),
-						Message: "Internal Server Error",
-						ErrorId: requestID,
-					}, nil
-				}
-			}
-
-			env.Logger.DebugContext(ctx, "deleting service in database",
-				slog.String("service", service.ServiceName))
-			err = env.Database.DeleteServiceById(ctx, service.ID)
+			_, err = kubernetes.CreateDeployment(ctx, deployRequest.Namespace, deploymentSpec, env)
 			if err != nil {
-				env.Logger.ErrorContext(ctx, "failed to delete service",
-					slog.String("service", service.ServiceName),
+				env.Logger.ErrorContext(ctx, "failed to create deployment",
+					slog.String("service", serviceConfig.Name),
 					slog.Any("error", err))
 				return PostDeploy500JSONResponse{
 					Status:  apierror.InternalServerError.Status(),
@@ -411,51 +1432,55 @@ func (Server) PostDeploy(
 					ErrorId: requestID,
 				}, nil
 			}
-		}
-	}
-
-	env.Logger.DebugContext(ctx, "creating services and deployments",
-		slog.String("project", project.Name),
-		slog.String("branch", deployRequest.BranchName))
-	serviceUrls := make(map[string][]string)
-	for _, serviceConfig := range config.Services {
 
-		// Create deployment
-		env.Logger.DebugContext(ctx, "creating deployment",
-			slog.String("service", serviceConfig.Name))
-		deploymentSpec, err := kubernetes.GenerateDeploymentSpec(
-			ctx, &deployRequest, &serviceConfig, env)
-		if err != nil {
-			env.Logger.ErrorContext(ctx, "failed to create deployment",
-				slog.String("service", serviceConfig.Name),
-				slog.Any("error", err))
-			return PostDeploy500JSONResponse{
-				Status:  apierror.InternalServerError.Status(),
-				Code:    apierror.InternalServerError.String(),
-				Message: "Internal Server Error",
-				ErrorId: requestID,
-			}, nil
-		}
-		_, err = kubernetes.CreateDeployment(ctx, deployRequest.Namespace, deploymentSpec, env)
-		if err != nil {
-			env.Logger.ErrorContext(ctx, "failed to create deployment",
-				slog.String("service", serviceConfig.Name),
-				slog.Any("error", err))
-			return PostDeploy500JSONResponse{
-				Status:  apierror.InternalServerError.Status(),
-				Code:    apierror.InternalServerError.String(),
-				Message: "Internal Server Error",
-				ErrorId: requestID,
-			}, nil
+			if serviceConfig.Template == "static" {
+				if artifactHeader == nil {
+					env.Logger.WarnContext(ctx, "no artifact uploaded - serving existing content",
+						slog.String("service", serviceConfig.Name))
+				} else {
+					env.Logger.DebugContext(ctx, "deploying artifact",
+						slog.String("service", serviceConfig.Name),
+						slog.String("artifact", artifactHeader.Filename))
+					err = deployArtifact(ctx, &deployRequest, &serviceConfig, artifactHeader, env)
+					if err != nil {
+						env.Logger.ErrorContext(ctx, "failed to deploy artifact",
+							slog.String("service", serviceConfig.Name),
+							slog.Any("error", err))
+						return PostDeploy500JSONResponse{
+							Status:  apierror.InternalServerError.Status(),
+							Code:    apierror.InternalServerError.String(),
+							Message: "Internal Server Error",
+							ErrorId: requestID,
+						}, nil
+					}
+				}
+			}
 		}
 
 		// Create service if ports specified or template requires it
 		oldService, svcExists := existingServices[serviceConfig.Name]
 		var kubeSvc *corev1.Service
-		if shouldCreateKubeService(&serviceConfig) {
+		if sharedHost != "" {
+			env.Logger.DebugContext(ctx, "creating external service",
+				slog.String("service", serviceConfig.Name),
+				slog.String("host", sharedHost))
+			kubeSvc, err = kubernetes.CreateService(ctx, deployRequest.Namespace,
+				kubernetes.GenerateExternalServiceSpec(deployRequest.Namespace, serviceConfig.Name, sharedHost), env)
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to create service",
+					slog.String("service", serviceConfig.Name),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+		} else if shouldCreateKubeService(&serviceConfig) {
 			env.Logger.DebugContext(ctx, "creating service",
 				slog.String("service", serviceConfig.Name))
-			serviceSpec, err := kubernetes.GenerateServiceSpec(deployRequest.Namespace, &serviceConfig, oldService)
+			serviceSpec, err := kubernetes.GenerateServiceSpec(deployRequest.Namespace, &serviceConfig)
 			if err != nil {
 				env.Logger.ErrorContext(ctx, "failed to generate service spec",
 					slog.String("service", serviceConfig.Name),
@@ -481,6 +1506,62 @@ func (Server) PostDeploy(
 			}
 		}
 
+		// Expose public ports on node ports
+		var publicSvc *corev1.Service
+		if kubeSvc != nil && sharedHost == "" && len(kubernetes.PublicPorts(&serviceConfig)) > 0 {
+			env.Logger.DebugContext(ctx, "creating public service",
+				slog.String("service", serviceConfig.Name))
+			nodePorts, err := reserveNodePorts(ctx, &deployRequest, &serviceConfig, oldService, env)
+			if errors.Is(err, errNodePortUnavailable) {
+				env.Logger.ErrorContext(ctx, "failed to reserve node ports",
+					slog.String("service", serviceConfig.Name),
+					slog.Any("error", err))
+				return PostDeploy409JSONResponse{
+					Status:  apierror.NodePortUnavailable.Status(),
+					Code:    apierror.NodePortUnavailable.String(),
+					Message: fmt.Sprintf("service %s: %s", serviceConfig.Name, err),
+					ErrorId: requestID,
+				}, nil
+			}
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to reserve node ports",
+					slog.String("service", serviceConfig.Name),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+			publicSvc, err = kubernetes.CreateService(ctx, deployRequest.Namespace,
+				kubernetes.GeneratePublicServiceSpec(deployRequest.Namespace, &serviceConfig, nodePorts), env)
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to create public service",
+					slog.String("service", serviceConfig.Name),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+		} else {
+			err = deletePublicService(ctx, &deployRequest, serviceConfig.Name, env)
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to delete public service",
+					slog.String("service", serviceConfig.Name),
+					slog.Any("error", err))
+				return PostDeploy500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestID,
+				}, nil
+			}
+		}
+
 		var urls []string
 		var newSvc database.Service
 		if !svcExists {
@@ -519,7 +1600,7 @@ func (Server) PostDeploy(
 		env.Logger.DebugContext(ctx, "updating service networking in database",
 			slog.String("service", serviceConfig.Name))
 		if kubeSvc == nil {
-			if serviceConfig.Template != "http" {
+			if !serviceConfig.ServesHTTP() {
 				env.Logger.DebugContext(ctx, "no service ports specified - clearing node ports",
 					slog.String("service", serviceConfig.Name))
 				err := env.Database.SetServiceNodePorts(ctx, database.SetServiceNodePortsParams{
@@ -555,6 +1636,19 @@ func (Server) PostDeploy(
 							ErrorId: requestID,
 						}, nil
 					}
+					err = kubernetes.DeleteIngressByName(ctx, deployRequest.Namespace,
+						kubernetes.CertificateIngressName(serviceConfig.Name), env)
+					if err != nil {
+						env.Logger.ErrorContext(ctx, "failed to delete certificate ingress",
+							slog.String("service", serviceConfig.Name),
+							slog.Any("error", err))
+						return PostDeploy500JSONResponse{
+							Status:  apierror.InternalServerError.Status(),
+							Code:    apierror.InternalServerError.String(),
+							Message: "Internal Server Error",
+							ErrorId: requestID,
+						}, nil
+					}
 				}
 				err = env.Database.SetServiceIngress(ctx, database.SetServiceIngressParams{
 					ID:      serviceID,
@@ -576,8 +1670,8 @@ func (Server) PostDeploy(
 			continue
 		}
 
-		if serviceConfig.Template != "http" {
-			if !serviceConfig.Public {
+		if !serviceConfig.ServesHTTP() {
+			if publicSvc == nil {
 				env.Logger.DebugContext(ctx, "clearing node ports for private service",
 					slog.String("service", serviceConfig.Name))
 				err := env.Database.SetServiceNodePorts(ctx, database.SetServiceNodePortsParams{
@@ -602,9 +1696,9 @@ func (Server) PostDeploy(
 			var nodePorts []int32
 			env.Logger.DebugContext(ctx, "retrieving node ports from spec",
 				slog.String("service", serviceConfig.Name))
-			for _, port := range kubeSvc.Spec.Ports {
+			for _, port := range publicSvc.Spec.Ports {
 				nodePorts = append(nodePorts, port.NodePort)
-				urls = append(urls, utils.FormatServiceURL(env.Config.Domain, port.NodePort))
+				urls = append(urls, utils.FormatServiceURL(env.Config.Domain, port.NodePort, port.Protocol))
 			}
 			err = env.Database.SetServiceNodePorts(ctx, database.SetServiceNodePortsParams{
 				ID:        serviceID,
@@ -639,6 +1733,19 @@ func (Server) PostDeploy(
 							ErrorId: requestID,
 						}, nil
 					}
+					err = kubernetes.DeleteIngressByName(ctx, deployRequest.Namespace,
+						kubernetes.CertificateIngressName(serviceConfig.Name), env)
+					if err != nil {
+						env.Logger.ErrorContext(ctx, "failed to delete certificate ingress",
+							slog.String("service", serviceConfig.Name),
+							slog.Any("error", err))
+						return PostDeploy500JSONResponse{
+							Status:  apierror.InternalServerError.Status(),
+							Code:    apierror.InternalServerError.String(),
+							Message: "Internal Server Error",
+							ErrorId: requestID,
+						}, nil
+					}
 				}
 				err = env.Database.SetServiceIngress(ctx, database.SetServiceIngressParams{
 					ID:      serviceID,
@@ -659,9 +1766,53 @@ func (Server) PostDeploy(
 				continue
 			}
 
+			// projects opting into a host pattern get readable hosts, existing
+			// random hosts are migrated on the next deploy
+			ingressHost := existingIngress
+			if config.HostPattern != "" {
+				host := utils.FormatHost(config.HostPattern,
+					serviceConfig.Name, deployRequest.BranchName, project.Name, env.Config.Domain)
+				key := fmt.Sprintf("%s/%s/%s", project.Name, deployRequest.BranchName, serviceConfig.Name)
+				ingressHost = nil
+				for _, candidate := range []string{host, utils.DisambiguateHost(host, key)} {
+					owner, err := env.Database.GetServiceByIngress(ctx, pgtype.Text{String: candidate, Valid: true})
+					if errors.Is(err, pgx.ErrNoRows) || (err == nil && owner.ID == serviceID) {
+						ingressHost = &candidate
+						break
+					}
+					if err != nil {
+						env.Logger.ErrorContext(ctx, "failed to check ingress host",
+							slog.String("service", serviceConfig.Name),
+							slog.String("host", candidate),
+							slog.Any("error", err))
+						return PostDeploy500JSONResponse{
+							Status:  apierror.InternalServerError.Status(),
+							Code:    apierror.InternalServerError.String(),
+							Message: "Internal Server Error",
+							ErrorId: requestID,
+						}, nil
+					}
+					env.Logger.DebugContext(ctx, "ingress host collision",
+						slog.String("service", serviceConfig.Name),
+						slog.String("host", candidate))
+				}
+				if ingressHost == nil {
+					env.Logger.ErrorContext(ctx, "ingress host is already in use",
+						slog.String("service", serviceConfig.Name),
+						slog.String("host", host))
+					return PostDeploy409JSONResponse{
+						Status:  apierror.DomainTaken.Status(),
+						Code:    apierror.DomainTaken.String(),
+						Message: fmt.Sprintf("host %s is already in use", host),
+						ErrorId: requestID,
+					}, nil
+				}
+			}
+
 			env.Logger.DebugContext(ctx, "creating ingress for service",
 				slog.String("service", serviceConfig.Name))
-			ingressSpec, err := kubernetes.GenerateIngressSpec(deployRequest.Namespace, &serviceConfig, existingIngress, env)
+			ingressSpecs, err := kubernetes.GenerateIngressSpec(deployRequest.Namespace, &serviceConfig,
+				ingressHost, deployRequest.PreviewProtection, certificates, env)
 			if err != nil {
 				env.Logger.ErrorContext(ctx, "failed to create ingress for service",
 					slog.String("service", serviceConfig.Name),
@@ -673,18 +1824,40 @@ func (Server) PostDeploy(
 					ErrorId: requestID,
 				}, nil
 			}
-			newIngress, err := kubernetes.CreateIngress(ctx, deployRequest.Namespace, ingressSpec, env)
-			if err != nil {
-				env.Logger.ErrorContext(ctx, "failed to create ingress",
-					slog.String("service", serviceConfig.Name),
-					slog.Any("error", err))
-				return PostDeploy500JSONResponse{
-					Status:  apierror.InternalServerError.Status(),
-					Code:    apierror.InternalServerError.String(),
-					Message: "Internal Server Error",
-					ErrorId: requestID,
-				}, nil
+			newIngresses := make([]*networkingv1.Ingress, 0, len(ingressSpecs))
+			for _, ingressSpec := range ingressSpecs {
+				newIngress, err := kubernetes.CreateIngress(ctx, deployRequest.Namespace, ingressSpec, env)
+				if err != nil {
+					env.Logger.ErrorContext(ctx, "failed to create ingress",
+						slog.String("service", serviceConfig.Name),
+						slog.String("ingress", ingressSpec.Name),
+						slog.Any("error", err))
+					return PostDeploy500JSONResponse{
+						Status:  apierror.InternalServerError.Status(),
+						Code:    apierror.InternalServerError.String(),
+						Message: "Internal Server Error",
+						ErrorId: requestID,
+					}, nil
+				}
+				newIngresses = append(newIngresses, newIngress)
+			}
+			if len(newIngresses) == 1 {
+				// no uploaded certificates are used anymore
+				err = kubernetes.DeleteIngressByName(ctx, deployRequest.Namespace,
+					kubernetes.CertificateIngressName(serviceConfig.Name), env)
+				if err != nil {
+					env.Logger.ErrorContext(ctx, "failed to delete certificate ingress",
+						slog.String("service", serviceConfig.Name),
+						slog.Any("error", err))
+					return PostDeploy500JSONResponse{
+						Status:  apierror.InternalServerError.Status(),
+						Code:    apierror.InternalServerError.String(),
+						Message: "Internal Server Error",
+						ErrorId: requestID,
+					}, nil
+				}
 			}
+			newIngress := newIngresses[0]
 			err = env.Database.SetServiceIngress(ctx, database.SetServiceIngressParams{
 				ID:      serviceID,
 				Ingress: pgtype.Text{String: newIngress.Spec.Rules[0].Host, Valid: true},
@@ -701,7 +1874,11 @@ func (Server) PostDeploy(
 					ErrorId: requestID,
 				}, nil
 			}
-			urls = append(urls, fmt.Sprintf("https://%s", newIngress.Spec.Rules[0].Host))
+			for _, ingress := range newIngresses {
+				for _, rule := range ingress.Spec.Rules {
+					urls = append(urls, fmt.Sprintf("https://%s", rule.Host))
+				}
+			}
 		}
 
 		env.Logger.DebugContext(ctx, "successfully created service",
@@ -709,7 +1886,50 @@ func (Server) PostDeploy(
 		serviceUrls[serviceConfig.Name] = urls
 	}
 
-	return PostDeploy200JSONResponse{
+	// Delete volumes removed from the config once their grace period ends
+	env.Logger.DebugContext(ctx, "cleaning up unused volumes",
+		slog.String("project", project.Name),
+		slog.String("branch", deployRequest.BranchName))
+	err = kubernetes.CleanupUnusedVolumes(ctx, &deployRequest, volumeRetention, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to clean up unused volumes",
+			slog.String("project", project.Name),
+			slog.Any("error", err))
+		return PostDeploy500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestID,
+		}, nil
+	}
+
+	response := PostDeploy200JSONResponse{
 		Services: serviceUrls,
-	}, nil
+	}
+	if len(deployRequest.VolumeResizes) > 0 {
+		resizes := make([]VolumeResize, len(deployRequest.VolumeResizes))
+		for i, resize := range deployRequest.VolumeResizes {
+			resizes[i] = VolumeResize{
+				Service: resize.Service,
+				Volume:  resize.Volume,
+				From:    resize.From,
+				To:      resize.To,
+				Status:  VolumeResizeStatus(resize.Status),
+			}
+		}
+		response.Volumes = &resizes
+	}
+	if len(deployRequest.VolumeCleanups) > 0 {
+		cleanups := make([]VolumeCleanup, len(deployRequest.VolumeCleanups))
+		for i, cleanup := range deployRequest.VolumeCleanups {
+			cleanups[i] = VolumeCleanup{
+				Volume:      cleanup.Volume,
+				Status:      VolumeCleanupStatus(cleanup.Status),
+				DeleteAfter: cleanup.DeleteAfter,
+			}
+		}
+		response.UnusedVolumes = &cleanups
+	}
+
+	return response, nil
 }
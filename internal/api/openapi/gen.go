@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -36,6 +37,13 @@ const (
 	PodStatusPhaseUnknown   PodStatusPhase = "Unknown"
 )
 
+// Defines values for PreviewProtectionMode.
+const (
+	BasicAuth   PreviewProtectionMode = "basic-auth"
+	IpAllowlist PreviewProtectionMode = "ip-allowlist"
+	None        PreviewProtectionMode = "none"
+)
+
 // Defines values for ServiceListItemStatus.
 const (
 	ServiceListItemStatusFailed    ServiceListItemStatus = "Failed"
@@ -45,10 +53,55 @@ const (
 	ServiceListItemStatusUnknown   ServiceListItemStatus = "Unknown"
 )
 
+// Defines values for VolumeCleanupStatus.
+const (
+	Deleted   VolumeCleanupStatus = "deleted"
+	Scheduled VolumeCleanupStatus = "scheduled"
+)
+
+// Defines values for VolumeResizeStatus.
+const (
+	Resizing    VolumeResizeStatus = "resizing"
+	Unsupported VolumeResizeStatus = "unsupported"
+)
+
+// Defines values for VolumeSnapshotMethod.
+const (
+	Copy VolumeSnapshotMethod = "copy"
+	Csi  VolumeSnapshotMethod = "csi"
+)
+
+// Certificate defines model for Certificate.
+type Certificate struct {
+	// Domain The custom domain covered by the certificate
+	Domain string `json:"domain"`
+
+	// ExpiresAt When the certificate expires
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// CertificateUpload defines model for CertificateUpload.
+type CertificateUpload struct {
+	// Certificate PEM encoded certificate chain
+	Certificate string `json:"certificate"`
+
+	// Domain The custom domain covered by the certificate
+	Domain string `json:"domain"`
+
+	// Key PEM encoded private key
+	Key string `json:"key"`
+}
+
 // Deployments defines model for Deployments.
 type Deployments struct {
 	// Services Map of service names to their URLs
 	Services map[string][]string `json:"services"`
+
+	// UnusedVolumes Volumes removed from nimbus.yaml that were deleted or scheduled for deletion
+	UnusedVolumes *[]VolumeCleanup `json:"unusedVolumes,omitempty"`
+
+	// Volumes Volumes resized by the deployment
+	Volumes *[]VolumeResize `json:"volumes,omitempty"`
 }
 
 // Error defines model for Error.
@@ -71,6 +124,24 @@ type PodStatus struct {
 // PodStatusPhase The current phase of the pod
 type PodStatusPhase string
 
+// PreviewProtection defines model for PreviewProtection.
+type PreviewProtection struct {
+	// Allowlist IP addresses or CIDR ranges allowed to access previews
+	Allowlist *[]string `json:"allowlist,omitempty"`
+
+	// Mode How preview branches are protected, none removes the protection
+	Mode PreviewProtectionMode `json:"mode"`
+
+	// Password Basic auth password, stored hashed
+	Password *string `json:"password,omitempty"`
+
+	// Username Basic auth username
+	Username *string `json:"username,omitempty"`
+}
+
+// PreviewProtectionMode How preview branches are protected, none removes the protection
+type PreviewProtectionMode string
+
 // Project defines model for Project.
 type Project struct {
 	// Id Unique identifier for the project
@@ -80,16 +151,75 @@ type Project struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// RestartedServices The services of a branch restarted to read changed secrets
+type RestartedServices struct {
+	Branch   string   `json:"branch"`
+	Services []string `json:"services"`
+}
+
+// SecretSources Set for branches, whether each secret is inherited from the project defaults or overridden
+type SecretSources map[string]string
+
+// SecretVersion defines model for SecretVersion.
+type SecretVersion struct {
+	Added     []string  `json:"added"`
+	Changed   []string  `json:"changed"`
+	CreatedAt time.Time `json:"createdAt"`
+	Removed   []string  `json:"removed"`
+
+	// User The user who made the change, empty for secrets recorded before history was kept
+	User    *string `json:"user,omitempty"`
+	Version int32   `json:"version"`
+}
+
 // SecretsNamesResponse defines model for SecretsNamesResponse.
 type SecretsNamesResponse struct {
 	// Secrets List of secret names
 	Secrets *[]string `json:"secrets,omitempty"`
+
+	// Sources Set for branches, whether each secret is inherited from the project defaults or overridden
+	Sources *SecretSources `json:"sources,omitempty"`
+
+	// Version The resource version of the defaults, or of the overrides for branches. Pass it when updating to fail with a conflict instead of overwriting concurrent changes.
+	Version int32 `json:"version"`
+}
+
+// SecretsPatch defines model for SecretsPatch.
+type SecretsPatch struct {
+	// Set Map of secret keys to set to their values
+	Set *map[string]string `json:"set,omitempty"`
+
+	// Unset Secret keys to remove
+	Unset *[]string `json:"unset,omitempty"`
+
+	// Version The expected resource version, the keys are applied to the latest secrets when omitted
+	Version *int32 `json:"version,omitempty"`
+}
+
+// SecretsRollback defines model for SecretsRollback.
+type SecretsRollback struct {
+	Restarted []RestartedServices `json:"restarted"`
+	Version   SecretVersion       `json:"version"`
+}
+
+// SecretsUpdate defines model for SecretsUpdate.
+type SecretsUpdate struct {
+	Restarted []RestartedServices `json:"restarted"`
+
+	// Version The resource version after the update
+	Version int32 `json:"version"`
 }
 
 // SecretsValuesResponse defines model for SecretsValuesResponse.
 type SecretsValuesResponse struct {
 	// Secrets Map of secret names to values
 	Secrets *map[string]string `json:"secrets,omitempty"`
+
+	// Sources Set for branches, whether each secret is inherited from the project defaults or overridden
+	Sources *SecretSources `json:"sources,omitempty"`
+
+	// Version The resource version of the defaults, or of the overrides for branches. Pass it when updating to fail with a conflict instead of overwriting concurrent changes.
+	Version int32 `json:"version"`
 }
 
 // ServiceDetail defines model for ServiceDetail.
@@ -134,6 +264,85 @@ type ServiceListItem struct {
 // ServiceListItemStatus The current status of the service
 type ServiceListItemStatus string
 
+// Volume defines model for Volume.
+type Volume struct {
+	// Capacity Capacity provisioned by the storage class, empty while unbound
+	Capacity *string `json:"capacity,omitempty"`
+
+	// DeleteAfter Set when the volume was removed from nimbus.yaml and is deleted by the next deploy after it
+	DeleteAfter *time.Time `json:"deleteAfter,omitempty"`
+
+	// Identifier The unique identifier of the volume
+	Identifier string `json:"identifier"`
+
+	// Name The name of the volume in nimbus.yaml
+	Name string `json:"name"`
+
+	// Services Services mounting the volume
+	Services []string `json:"services"`
+
+	// Size Requested size in Mi
+	Size int32 `json:"size"`
+
+	// Status Phase of the volume claim (Bound, Pending, Lost or Missing)
+	Status string `json:"status"`
+
+	// Storage The storage tier of the volume, empty for the default storage class
+	Storage *string `json:"storage,omitempty"`
+}
+
+// VolumeCleanup defines model for VolumeCleanup.
+type VolumeCleanup struct {
+	// DeleteAfter When the volume is deleted by the next deploy
+	DeleteAfter time.Time `json:"deleteAfter"`
+
+	// Status deleted when the volume was reclaimed, scheduled while it is kept for the grace period
+	Status VolumeCleanupStatus `json:"status"`
+	Volume string              `json:"volume"`
+}
+
+// VolumeCleanupStatus deleted when the volume was reclaimed, scheduled while it is kept for the grace period
+type VolumeCleanupStatus string
+
+// VolumeResize defines model for VolumeResize.
+type VolumeResize struct {
+	// From Previous size in Mi
+	From    int32  `json:"from"`
+	Service string `json:"service"`
+
+	// Status resizing when the volume is being expanded, unsupported when the storage class does not allow expansion
+	Status VolumeResizeStatus `json:"status"`
+
+	// To Requested size in Mi
+	To     int32  `json:"to"`
+	Volume string `json:"volume"`
+}
+
+// VolumeResizeStatus resizing when the volume is being expanded, unsupported when the storage class does not allow expansion
+type VolumeResizeStatus string
+
+// VolumeSnapshot defines model for VolumeSnapshot.
+type VolumeSnapshot struct {
+	Branch    string             `json:"branch"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Method csi when taken by the storage driver, copy when the files were copied into a separate volume
+	Method VolumeSnapshotMethod `json:"method"`
+
+	// Ready Whether the snapshot can be restored
+	Ready bool `json:"ready"`
+
+	// Size Size of the volume in Mi when the snapshot was taken
+	Size int32 `json:"size"`
+
+	// Volume The name of the snapshotted volume
+	Volume string `json:"volume"`
+}
+
+// VolumeSnapshotMethod csi when taken by the storage driver, copy when the files were copied into a separate volume
+type VolumeSnapshotMethod string
+
 // DeleteBranchParams defines parameters for DeleteBranch.
 type DeleteBranchParams struct {
 	// Project The name of the project
@@ -148,6 +357,9 @@ type DeleteBranchParams struct {
 
 // PostDeployMultipartBody defines parameters for PostDeploy.
 type PostDeployMultipartBody struct {
+	// Artifact Gzipped tarball served by the static services of the project
+	Artifact *openapi_types.File `json:"artifact,omitempty"`
+
 	// Branch The branch name to deploy (defaults to 'main')
 	Branch *string `json:"branch,omitempty"`
 
@@ -185,8 +397,29 @@ type DeleteProjectsNameParams struct {
 	XAPIKey *string `json:"X-API-Key,omitempty"`
 }
 
+// GetProjectsNameCertificatesParams defines parameters for GetProjectsNameCertificates.
+type GetProjectsNameCertificatesParams struct {
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// PostProjectsNameCertificatesParams defines parameters for PostProjectsNameCertificates.
+type PostProjectsNameCertificatesParams struct {
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// PutProjectsNameProtectionParams defines parameters for PutProjectsNameProtection.
+type PutProjectsNameProtectionParams struct {
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
 // GetProjectsNameSecretsParams defines parameters for GetProjectsNameSecrets.
 type GetProjectsNameSecretsParams struct {
+	// Branch The branch to resolve the secrets of, the project defaults when omitted
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
 	// Values Set to 'true' to return secret values
 	Values *bool `form:"values,omitempty" json:"values,omitempty"`
 
@@ -194,14 +427,119 @@ type GetProjectsNameSecretsParams struct {
 	XAPIKey *string `json:"X-API-Key,omitempty"`
 }
 
+// PatchProjectsNameSecretsParams defines parameters for PatchProjectsNameSecrets.
+type PatchProjectsNameSecretsParams struct {
+	// Branch The branch to change the overrides of, the project defaults when omitted
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
+	// Restart Whether to restart the services reading changed secrets, true by default
+	Restart *bool `form:"restart,omitempty" json:"restart,omitempty"`
+
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
 // PutProjectsNameSecretsJSONBody defines parameters for PutProjectsNameSecrets.
 type PutProjectsNameSecretsJSONBody struct {
 	// Secrets Map of secret keys to values
 	Secrets *map[string]string `json:"secrets,omitempty"`
+
+	// Version The expected resource version, the secrets are replaced regardless when omitted
+	Version *int32 `json:"version,omitempty"`
 }
 
 // PutProjectsNameSecretsParams defines parameters for PutProjectsNameSecrets.
 type PutProjectsNameSecretsParams struct {
+	// Branch The branch to replace the overrides of, the project defaults when omitted
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
+	// Restart Whether to restart the services reading changed secrets, true by default
+	Restart *bool `form:"restart,omitempty" json:"restart,omitempty"`
+
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// GetProjectsNameSecretsVersionsParams defines parameters for GetProjectsNameSecretsVersions.
+type GetProjectsNameSecretsVersionsParams struct {
+	// Branch The branch whose overrides to use, the project defaults when omitted
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// PostProjectsNameSecretsVersionsVersionRollbackParams defines parameters for PostProjectsNameSecretsVersionsVersionRollback.
+type PostProjectsNameSecretsVersionsVersionRollbackParams struct {
+	// Branch The branch whose overrides to use, the project defaults when omitted
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
+	// Restart Whether to restart the services reading changed secrets, true by default
+	Restart *bool `form:"restart,omitempty" json:"restart,omitempty"`
+
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// GetProjectsNameSnapshotsParams defines parameters for GetProjectsNameSnapshots.
+type GetProjectsNameSnapshotsParams struct {
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// PostProjectsNameSnapshotsSnapshotRestoreParams defines parameters for PostProjectsNameSnapshotsSnapshotRestore.
+type PostProjectsNameSnapshotsSnapshotRestoreParams struct {
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// GetProjectsNameVolumesParams defines parameters for GetProjectsNameVolumes.
+type GetProjectsNameVolumesParams struct {
+	// Branch The branch name (defaults to 'main')
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// DeleteProjectsNameVolumesVolumeParams defines parameters for DeleteProjectsNameVolumesVolume.
+type DeleteProjectsNameVolumesVolumeParams struct {
+	// Branch The branch name (defaults to 'main')
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// GetProjectsNameVolumesVolumeArchiveParams defines parameters for GetProjectsNameVolumesVolumeArchive.
+type GetProjectsNameVolumesVolumeArchiveParams struct {
+	// Branch The branch name (defaults to 'main')
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
+	// Path Directory or file of the volume to download (defaults to the whole volume)
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// PutProjectsNameVolumesVolumeArchiveParams defines parameters for PutProjectsNameVolumesVolumeArchive.
+type PutProjectsNameVolumesVolumeArchiveParams struct {
+	// Branch The branch name (defaults to 'main')
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
+	// Path Directory of the volume to unpack into (defaults to the root of the volume)
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+
+	// XAPIKey API key for authentication
+	XAPIKey *string `json:"X-API-Key,omitempty"`
+}
+
+// PostProjectsNameVolumesVolumeSnapshotsParams defines parameters for PostProjectsNameVolumesVolumeSnapshots.
+type PostProjectsNameVolumesVolumeSnapshotsParams struct {
+	// Branch The branch name (defaults to 'main')
+	Branch *string `form:"branch,omitempty" json:"branch,omitempty"`
+
 	// XAPIKey API key for authentication
 	XAPIKey *string `json:"X-API-Key,omitempty"`
 }
@@ -242,6 +580,15 @@ type PostDeployMultipartRequestBody PostDeployMultipartBody
 // PostProjectsJSONRequestBody defines body for PostProjects for application/json ContentType.
 type PostProjectsJSONRequestBody PostProjectsJSONBody
 
+// PostProjectsNameCertificatesJSONRequestBody defines body for PostProjectsNameCertificates for application/json ContentType.
+type PostProjectsNameCertificatesJSONRequestBody = CertificateUpload
+
+// PutProjectsNameProtectionJSONRequestBody defines body for PutProjectsNameProtection for application/json ContentType.
+type PutProjectsNameProtectionJSONRequestBody = PreviewProtection
+
+// PatchProjectsNameSecretsJSONRequestBody defines body for PatchProjectsNameSecrets for application/json ContentType.
+type PatchProjectsNameSecretsJSONRequestBody = SecretsPatch
+
 // PutProjectsNameSecretsJSONRequestBody defines body for PutProjectsNameSecrets for application/json ContentType.
 type PutProjectsNameSecretsJSONRequestBody PutProjectsNameSecretsJSONBody
 
@@ -341,14 +688,59 @@ type ClientInterface interface {
 	// DeleteProjectsName request
 	DeleteProjectsName(ctx context.Context, name string, params *DeleteProjectsNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetProjectsNameCertificates request
+	GetProjectsNameCertificates(ctx context.Context, name string, params *GetProjectsNameCertificatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostProjectsNameCertificatesWithBody request with any body
+	PostProjectsNameCertificatesWithBody(ctx context.Context, name string, params *PostProjectsNameCertificatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostProjectsNameCertificates(ctx context.Context, name string, params *PostProjectsNameCertificatesParams, body PostProjectsNameCertificatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutProjectsNameProtectionWithBody request with any body
+	PutProjectsNameProtectionWithBody(ctx context.Context, name string, params *PutProjectsNameProtectionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutProjectsNameProtection(ctx context.Context, name string, params *PutProjectsNameProtectionParams, body PutProjectsNameProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetProjectsNameSecrets request
 	GetProjectsNameSecrets(ctx context.Context, name string, params *GetProjectsNameSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchProjectsNameSecretsWithBody request with any body
+	PatchProjectsNameSecretsWithBody(ctx context.Context, name string, params *PatchProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchProjectsNameSecrets(ctx context.Context, name string, params *PatchProjectsNameSecretsParams, body PatchProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutProjectsNameSecretsWithBody request with any body
 	PutProjectsNameSecretsWithBody(ctx context.Context, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutProjectsNameSecrets(ctx context.Context, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetProjectsNameSecretsVersions request
+	GetProjectsNameSecretsVersions(ctx context.Context, name string, params *GetProjectsNameSecretsVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostProjectsNameSecretsVersionsVersionRollback request
+	PostProjectsNameSecretsVersionsVersionRollback(ctx context.Context, name string, version int32, params *PostProjectsNameSecretsVersionsVersionRollbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectsNameSnapshots request
+	GetProjectsNameSnapshots(ctx context.Context, name string, params *GetProjectsNameSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostProjectsNameSnapshotsSnapshotRestore request
+	PostProjectsNameSnapshotsSnapshotRestore(ctx context.Context, name string, snapshot openapi_types.UUID, params *PostProjectsNameSnapshotsSnapshotRestoreParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectsNameVolumes request
+	GetProjectsNameVolumes(ctx context.Context, name string, params *GetProjectsNameVolumesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProjectsNameVolumesVolume request
+	DeleteProjectsNameVolumesVolume(ctx context.Context, name string, volume string, params *DeleteProjectsNameVolumesVolumeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectsNameVolumesVolumeArchive request
+	GetProjectsNameVolumesVolumeArchive(ctx context.Context, name string, volume string, params *GetProjectsNameVolumesVolumeArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutProjectsNameVolumesVolumeArchiveWithBody request with any body
+	PutProjectsNameVolumesVolumeArchiveWithBody(ctx context.Context, name string, volume string, params *PutProjectsNameVolumesVolumeArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostProjectsNameVolumesVolumeSnapshots request
+	PostProjectsNameVolumesVolumeSnapshots(ctx context.Context, name string, volume string, params *PostProjectsNameVolumesVolumeSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetServices request
 	GetServices(ctx context.Context, params *GetServicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -455,8 +847,8 @@ func (c *Client) DeleteProjectsName(ctx context.Context, name string, params *De
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProjectsNameSecrets(ctx context.Context, name string, params *GetProjectsNameSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectsNameSecretsRequest(c.Server, name, params)
+func (c *Client) GetProjectsNameCertificates(ctx context.Context, name string, params *GetProjectsNameCertificatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsNameCertificatesRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -467,8 +859,8 @@ func (c *Client) GetProjectsNameSecrets(ctx context.Context, name string, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutProjectsNameSecretsWithBody(ctx context.Context, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutProjectsNameSecretsRequestWithBody(c.Server, name, params, contentType, body)
+func (c *Client) PostProjectsNameCertificatesWithBody(ctx context.Context, name string, params *PostProjectsNameCertificatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsNameCertificatesRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -479,8 +871,8 @@ func (c *Client) PutProjectsNameSecretsWithBody(ctx context.Context, name string
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutProjectsNameSecrets(ctx context.Context, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutProjectsNameSecretsRequest(c.Server, name, params, body)
+func (c *Client) PostProjectsNameCertificates(ctx context.Context, name string, params *PostProjectsNameCertificatesParams, body PostProjectsNameCertificatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsNameCertificatesRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -491,8 +883,8 @@ func (c *Client) PutProjectsNameSecrets(ctx context.Context, name string, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetServices(ctx context.Context, params *GetServicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetServicesRequest(c.Server, params)
+func (c *Client) PutProjectsNameProtectionWithBody(ctx context.Context, name string, params *PutProjectsNameProtectionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutProjectsNameProtectionRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -503,8 +895,8 @@ func (c *Client) GetServices(ctx context.Context, params *GetServicesParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetServicesName(ctx context.Context, name string, params *GetServicesNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetServicesNameRequest(c.Server, name, params)
+func (c *Client) PutProjectsNameProtection(ctx context.Context, name string, params *PutProjectsNameProtectionParams, body PutProjectsNameProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutProjectsNameProtectionRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -515,8 +907,8 @@ func (c *Client) GetServicesName(ctx context.Context, name string, params *GetSe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetServicesNameLogs(ctx context.Context, name string, params *GetServicesNameLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetServicesNameLogsRequest(c.Server, name, params)
+func (c *Client) GetProjectsNameSecrets(ctx context.Context, name string, params *GetProjectsNameSecretsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsNameSecretsRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -527,69 +919,261 @@ func (c *Client) GetServicesNameLogs(ctx context.Context, name string, params *G
 	return c.Client.Do(req)
 }
 
-// NewDeleteBranchRequest generates requests for DeleteBranch
-func NewDeleteBranchRequest(server string, params *DeleteBranchParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchProjectsNameSecretsWithBody(ctx context.Context, name string, params *PatchProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectsNameSecretsRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/branch")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchProjectsNameSecrets(ctx context.Context, name string, params *PatchProjectsNameSecretsParams, body PatchProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchProjectsNameSecretsRequest(c.Server, name, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project", runtime.ParamLocationQuery, params.Project); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, params.Branch); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) PutProjectsNameSecretsWithBody(ctx context.Context, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutProjectsNameSecretsRequestWithBody(c.Server, name, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-
-		if params.XAPIKey != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
-			if err != nil {
-				return nil, err
-			}
+func (c *Client) PutProjectsNameSecrets(ctx context.Context, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutProjectsNameSecretsRequest(c.Server, name, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectsNameSecretsVersions(ctx context.Context, name string, params *GetProjectsNameSecretsVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsNameSecretsVersionsRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostProjectsNameSecretsVersionsVersionRollback(ctx context.Context, name string, version int32, params *PostProjectsNameSecretsVersionsVersionRollbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsNameSecretsVersionsVersionRollbackRequest(c.Server, name, version, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectsNameSnapshots(ctx context.Context, name string, params *GetProjectsNameSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsNameSnapshotsRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostProjectsNameSnapshotsSnapshotRestore(ctx context.Context, name string, snapshot openapi_types.UUID, params *PostProjectsNameSnapshotsSnapshotRestoreParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsNameSnapshotsSnapshotRestoreRequest(c.Server, name, snapshot, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectsNameVolumes(ctx context.Context, name string, params *GetProjectsNameVolumesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsNameVolumesRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProjectsNameVolumesVolume(ctx context.Context, name string, volume string, params *DeleteProjectsNameVolumesVolumeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectsNameVolumesVolumeRequest(c.Server, name, volume, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectsNameVolumesVolumeArchive(ctx context.Context, name string, volume string, params *GetProjectsNameVolumesVolumeArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectsNameVolumesVolumeArchiveRequest(c.Server, name, volume, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutProjectsNameVolumesVolumeArchiveWithBody(ctx context.Context, name string, volume string, params *PutProjectsNameVolumesVolumeArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutProjectsNameVolumesVolumeArchiveRequestWithBody(c.Server, name, volume, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostProjectsNameVolumesVolumeSnapshots(ctx context.Context, name string, volume string, params *PostProjectsNameVolumesVolumeSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProjectsNameVolumesVolumeSnapshotsRequest(c.Server, name, volume, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetServices(ctx context.Context, params *GetServicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetServicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetServicesName(ctx context.Context, name string, params *GetServicesNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetServicesNameRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetServicesNameLogs(ctx context.Context, name string, params *GetServicesNameLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetServicesNameLogsRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewDeleteBranchRequest generates requests for DeleteBranch
+func NewDeleteBranchRequest(server string, params *DeleteBranchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/branch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project", runtime.ParamLocationQuery, params.Project); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, params.Branch); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
 
 			req.Header.Set("X-API-Key", headerParam0)
 		}
@@ -843,8 +1427,8 @@ func NewDeleteProjectsNameRequest(server string, name string, params *DeleteProj
 	return req, nil
 }
 
-// NewGetProjectsNameSecretsRequest generates requests for GetProjectsNameSecrets
-func NewGetProjectsNameSecretsRequest(server string, name string, params *GetProjectsNameSecretsParams) (*http.Request, error) {
+// NewGetProjectsNameCertificatesRequest generates requests for GetProjectsNameCertificates
+func NewGetProjectsNameCertificatesRequest(server string, name string, params *GetProjectsNameCertificatesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -859,7 +1443,7 @@ func NewGetProjectsNameSecretsRequest(server string, name string, params *GetPro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/secrets", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/certificates", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -869,28 +1453,6 @@ func NewGetProjectsNameSecretsRequest(server string, name string, params *GetPro
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Values != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "values", runtime.ParamLocationQuery, *params.Values); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -914,19 +1476,19 @@ func NewGetProjectsNameSecretsRequest(server string, name string, params *GetPro
 	return req, nil
 }
 
-// NewPutProjectsNameSecretsRequest calls the generic PutProjectsNameSecrets builder with application/json body
-func NewPutProjectsNameSecretsRequest(server string, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody) (*http.Request, error) {
+// NewPostProjectsNameCertificatesRequest calls the generic PostProjectsNameCertificates builder with application/json body
+func NewPostProjectsNameCertificatesRequest(server string, name string, params *PostProjectsNameCertificatesParams, body PostProjectsNameCertificatesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutProjectsNameSecretsRequestWithBody(server, name, params, "application/json", bodyReader)
+	return NewPostProjectsNameCertificatesRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-// NewPutProjectsNameSecretsRequestWithBody generates requests for PutProjectsNameSecrets with any type of body
-func NewPutProjectsNameSecretsRequestWithBody(server string, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostProjectsNameCertificatesRequestWithBody generates requests for PostProjectsNameCertificates with any type of body
+func NewPostProjectsNameCertificatesRequestWithBody(server string, name string, params *PostProjectsNameCertificatesParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -941,7 +1503,7 @@ func NewPutProjectsNameSecretsRequestWithBody(server string, name string, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/secrets", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/certificates", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -951,7 +1513,7 @@ func NewPutProjectsNameSecretsRequestWithBody(server string, name string, params
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -976,16 +1538,34 @@ func NewPutProjectsNameSecretsRequestWithBody(server string, name string, params
 	return req, nil
 }
 
-// NewGetServicesRequest generates requests for GetServices
-func NewGetServicesRequest(server string, params *GetServicesParams) (*http.Request, error) {
+// NewPutProjectsNameProtectionRequest calls the generic PutProjectsNameProtection builder with application/json body
+func NewPutProjectsNameProtectionRequest(server string, name string, params *PutProjectsNameProtectionParams, body PutProjectsNameProtectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutProjectsNameProtectionRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPutProjectsNameProtectionRequestWithBody generates requests for PutProjectsNameProtection with any type of body
+func NewPutProjectsNameProtectionRequestWithBody(server string, name string, params *PutProjectsNameProtectionParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/services")
+	operationPath := fmt.Sprintf("/projects/%s/protection", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -995,11 +1575,13 @@ func NewGetServicesRequest(server string, params *GetServicesParams) (*http.Requ
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		if params.XAPIKey != nil {
@@ -1018,8 +1600,8 @@ func NewGetServicesRequest(server string, params *GetServicesParams) (*http.Requ
 	return req, nil
 }
 
-// NewGetServicesNameRequest generates requests for GetServicesName
-func NewGetServicesNameRequest(server string, name string, params *GetServicesNameParams) (*http.Request, error) {
+// NewGetProjectsNameSecretsRequest generates requests for GetProjectsNameSecrets
+func NewGetProjectsNameSecretsRequest(server string, name string, params *GetProjectsNameSecretsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1034,7 +1616,7 @@ func NewGetServicesNameRequest(server string, name string, params *GetServicesNa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/services/%s", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/secrets", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1047,21 +1629,25 @@ func NewGetServicesNameRequest(server string, name string, params *GetServicesNa
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project", runtime.ParamLocationQuery, params.Project); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Branch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.Branch != nil {
+		if params.Values != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "values", runtime.ParamLocationQuery, *params.Values); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1101,8 +1687,19 @@ func NewGetServicesNameRequest(server string, name string, params *GetServicesNa
 	return req, nil
 }
 
-// NewGetServicesNameLogsRequest generates requests for GetServicesNameLogs
-func NewGetServicesNameLogsRequest(server string, name string, params *GetServicesNameLogsParams) (*http.Request, error) {
+// NewPatchProjectsNameSecretsRequest calls the generic PatchProjectsNameSecrets builder with application/json body
+func NewPatchProjectsNameSecretsRequest(server string, name string, params *PatchProjectsNameSecretsParams, body PatchProjectsNameSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchProjectsNameSecretsRequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewPatchProjectsNameSecretsRequestWithBody generates requests for PatchProjectsNameSecrets with any type of body
+func NewPatchProjectsNameSecretsRequestWithBody(server string, name string, params *PatchProjectsNameSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1117,7 +1714,7 @@ func NewGetServicesNameLogsRequest(server string, name string, params *GetServic
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/services/%s/logs", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/secrets", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1130,21 +1727,25 @@ func NewGetServicesNameLogsRequest(server string, name string, params *GetServic
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project", runtime.ParamLocationQuery, params.Project); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Branch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.Branch != nil {
+		if params.Restart != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "restart", runtime.ParamLocationQuery, *params.Restart); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1161,11 +1762,13 @@ func NewGetServicesNameLogsRequest(server string, name string, params *GetServic
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		if params.XAPIKey != nil {
@@ -1184,1128 +1787,3484 @@ func NewGetServicesNameLogsRequest(server string, name string, params *GetServic
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewPutProjectsNameSecretsRequest calls the generic PutProjectsNameSecrets builder with application/json body
+func NewPutProjectsNameSecretsRequest(server string, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPutProjectsNameSecretsRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewPutProjectsNameSecretsRequestWithBody generates requests for PutProjectsNameSecrets with any type of body
+func NewPutProjectsNameSecretsRequestWithBody(server string, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// DeleteBranchWithResponse request
-	DeleteBranchWithResponse(ctx context.Context, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*DeleteBranchResponse, error)
+	operationPath := fmt.Sprintf("/projects/%s/secrets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PostDeployWithBodyWithResponse request with any body
-	PostDeployWithBodyWithResponse(ctx context.Context, params *PostDeployParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeployResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetOpenapiYamlWithResponse request
-	GetOpenapiYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenapiYamlResponse, error)
+		if params.Branch != nil {
 
-	// GetProjectsWithResponse request
-	GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// PostProjectsWithBodyWithResponse request with any body
-	PostProjectsWithBodyWithResponse(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
+		}
 
-	PostProjectsWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
+		if params.Restart != nil {
 
-	// DeleteProjectsNameWithResponse request
-	DeleteProjectsNameWithResponse(ctx context.Context, name string, params *DeleteProjectsNameParams, reqEditors ...RequestEditorFn) (*DeleteProjectsNameResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "restart", runtime.ParamLocationQuery, *params.Restart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetProjectsNameSecretsWithResponse request
-	GetProjectsNameSecretsWithResponse(ctx context.Context, name string, params *GetProjectsNameSecretsParams, reqEditors ...RequestEditorFn) (*GetProjectsNameSecretsResponse, error)
+		}
 
-	// PutProjectsNameSecretsWithBodyWithResponse request with any body
-	PutProjectsNameSecretsWithBodyWithResponse(ctx context.Context, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutProjectsNameSecretsResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	PutProjectsNameSecretsWithResponse(ctx context.Context, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutProjectsNameSecretsResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetServicesWithResponse request
-	GetServicesWithResponse(ctx context.Context, params *GetServicesParams, reqEditors ...RequestEditorFn) (*GetServicesResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetServicesNameWithResponse request
-	GetServicesNameWithResponse(ctx context.Context, name string, params *GetServicesNameParams, reqEditors ...RequestEditorFn) (*GetServicesNameResponse, error)
+	if params != nil {
 
-	// GetServicesNameLogsWithResponse request
-	GetServicesNameLogsWithResponse(ctx context.Context, name string, params *GetServicesNameLogsParams, reqEditors ...RequestEditorFn) (*GetServicesNameLogsResponse, error)
-}
+		if params.XAPIKey != nil {
+			var headerParam0 string
 
-type DeleteBranchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
 
-// Status returns HTTPResponse.Status
-func (r DeleteBranchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			req.Header.Set("X-API-Key", headerParam0)
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteBranchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PostDeployResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Deployments
-	JSON400      *Error
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON409      *Error
-	JSON422      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostDeployResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetProjectsNameSecretsVersionsRequest generates requests for GetProjectsNameSecretsVersions
+func NewGetProjectsNameSecretsVersionsRequest(server string, name string, params *GetProjectsNameSecretsVersionsParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostDeployResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/projects/%s/secrets/versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetOpenapiYamlResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	YAML200      *string
-	JSON500      *Error
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetOpenapiYamlResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Branch != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOpenapiYamlResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Projects *[]Project `json:"projects,omitempty"`
-	}
-	JSON401 *Error
-	JSON500 *Error
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Project
-	JSON400      *Error
-	JSON401      *Error
-	JSON500      *Error
-}
+	if params != nil {
 
-// Status returns HTTPResponse.Status
-func (r PostProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.XAPIKey != nil {
+			var headerParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
 
-type DeleteProjectsNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+			req.Header.Set("X-API-Key", headerParam0)
+		}
 
-// Status returns HTTPResponse.Status
-func (r DeleteProjectsNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectsNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type GetProjectsNameSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		union json.RawMessage
-	}
-	JSON401 *Error
-	JSON403 *Error
-	JSON404 *Error
-	JSON500 *Error
-}
+// NewPostProjectsNameSecretsVersionsVersionRollbackRequest generates requests for PostProjectsNameSecretsVersionsVersionRollback
+func NewPostProjectsNameSecretsVersionsVersionRollbackRequest(server string, name string, version int32, params *PostProjectsNameSecretsVersionsVersionRollbackParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetProjectsNameSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectsNameSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutProjectsNameSecretsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r PutProjectsNameSecretsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutProjectsNameSecretsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetServicesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Services *[]ServiceListItem `json:"services,omitempty"`
+	operationPath := fmt.Sprintf("/projects/%s/secrets/versions/%s/rollback", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON401 *Error
-	JSON500 *Error
-}
 
-// Status returns HTTPResponse.Status
-func (r GetServicesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetServicesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type GetServicesNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceDetail
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+		if params.Branch != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetServicesNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetServicesNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		}
 
-type GetServicesNameLogsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+		if params.Restart != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetServicesNameLogsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "restart", runtime.ParamLocationQuery, *params.Restart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetServicesNameLogsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-// DeleteBranchWithResponse request returning *DeleteBranchResponse
-func (c *ClientWithResponses) DeleteBranchWithResponse(ctx context.Context, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*DeleteBranchResponse, error) {
-	rsp, err := c.DeleteBranch(ctx, params, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteBranchResponse(rsp)
-}
 
-// PostDeployWithBodyWithResponse request with arbitrary body returning *PostDeployResponse
-func (c *ClientWithResponses) PostDeployWithBodyWithResponse(ctx context.Context, params *PostDeployParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeployResponse, error) {
-	rsp, err := c.PostDeployWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	if params != nil {
+
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-API-Key", headerParam0)
+		}
+
 	}
-	return ParsePostDeployResponse(rsp)
+
+	return req, nil
 }
 
-// GetHealthWithResponse request returning *GetHealthResponse
-func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
-	rsp, err := c.GetHealth(ctx, reqEditors...)
+// NewGetProjectsNameSnapshotsRequest generates requests for GetProjectsNameSnapshots
+func NewGetProjectsNameSnapshotsRequest(server string, name string, params *GetProjectsNameSnapshotsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetHealthResponse(rsp)
-}
 
-// GetOpenapiYamlWithResponse request returning *GetOpenapiYamlResponse
-func (c *ClientWithResponses) GetOpenapiYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenapiYamlResponse, error) {
-	rsp, err := c.GetOpenapiYaml(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOpenapiYamlResponse(rsp)
-}
 
-// GetProjectsWithResponse request returning *GetProjectsResponse
-func (c *ClientWithResponses) GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error) {
-	rsp, err := c.GetProjects(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/projects/%s/snapshots", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetProjectsResponse(rsp)
-}
 
-// PostProjectsWithBodyWithResponse request with arbitrary body returning *PostProjectsResponse
-func (c *ClientWithResponses) PostProjectsWithBodyWithResponse(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
-	rsp, err := c.PostProjectsWithBody(ctx, params, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostProjectsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostProjectsWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
-	rsp, err := c.PostProjects(ctx, params, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostProjectsResponse(rsp)
-}
 
-// DeleteProjectsNameWithResponse request returning *DeleteProjectsNameResponse
-func (c *ClientWithResponses) DeleteProjectsNameWithResponse(ctx context.Context, name string, params *DeleteProjectsNameParams, reqEditors ...RequestEditorFn) (*DeleteProjectsNameResponse, error) {
-	rsp, err := c.DeleteProjectsName(ctx, name, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	if params != nil {
+
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-API-Key", headerParam0)
+		}
+
 	}
-	return ParseDeleteProjectsNameResponse(rsp)
+
+	return req, nil
 }
 
-// GetProjectsNameSecretsWithResponse request returning *GetProjectsNameSecretsResponse
-func (c *ClientWithResponses) GetProjectsNameSecretsWithResponse(ctx context.Context, name string, params *GetProjectsNameSecretsParams, reqEditors ...RequestEditorFn) (*GetProjectsNameSecretsResponse, error) {
-	rsp, err := c.GetProjectsNameSecrets(ctx, name, params, reqEditors...)
+// NewPostProjectsNameSnapshotsSnapshotRestoreRequest generates requests for PostProjectsNameSnapshotsSnapshotRestore
+func NewPostProjectsNameSnapshotsSnapshotRestoreRequest(server string, name string, snapshot openapi_types.UUID, params *PostProjectsNameSnapshotsSnapshotRestoreParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProjectsNameSecretsResponse(rsp)
-}
 
-// PutProjectsNameSecretsWithBodyWithResponse request with arbitrary body returning *PutProjectsNameSecretsResponse
-func (c *ClientWithResponses) PutProjectsNameSecretsWithBodyWithResponse(ctx context.Context, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutProjectsNameSecretsResponse, error) {
-	rsp, err := c.PutProjectsNameSecretsWithBody(ctx, name, params, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "snapshot", runtime.ParamLocationPath, snapshot)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutProjectsNameSecretsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutProjectsNameSecretsWithResponse(ctx context.Context, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutProjectsNameSecretsResponse, error) {
-	rsp, err := c.PutProjectsNameSecrets(ctx, name, params, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutProjectsNameSecretsResponse(rsp)
-}
 
-// GetServicesWithResponse request returning *GetServicesResponse
-func (c *ClientWithResponses) GetServicesWithResponse(ctx context.Context, params *GetServicesParams, reqEditors ...RequestEditorFn) (*GetServicesResponse, error) {
-	rsp, err := c.GetServices(ctx, params, reqEditors...)
+	operationPath := fmt.Sprintf("/projects/%s/snapshots/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetServicesResponse(rsp)
-}
 
-// GetServicesNameWithResponse request returning *GetServicesNameResponse
-func (c *ClientWithResponses) GetServicesNameWithResponse(ctx context.Context, name string, params *GetServicesNameParams, reqEditors ...RequestEditorFn) (*GetServicesNameResponse, error) {
-	rsp, err := c.GetServicesName(ctx, name, params, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetServicesNameResponse(rsp)
+
+	if params != nil {
+
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-API-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
 }
 
-// GetServicesNameLogsWithResponse request returning *GetServicesNameLogsResponse
-func (c *ClientWithResponses) GetServicesNameLogsWithResponse(ctx context.Context, name string, params *GetServicesNameLogsParams, reqEditors ...RequestEditorFn) (*GetServicesNameLogsResponse, error) {
-	rsp, err := c.GetServicesNameLogs(ctx, name, params, reqEditors...)
+// NewGetProjectsNameVolumesRequest generates requests for GetProjectsNameVolumes
+func NewGetProjectsNameVolumesRequest(server string, name string, params *GetProjectsNameVolumesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetServicesNameLogsResponse(rsp)
-}
 
-// ParseDeleteBranchResponse parses an HTTP response from a DeleteBranchWithResponse call
-func ParseDeleteBranchResponse(rsp *http.Response) (*DeleteBranchResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteBranchResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/projects/%s/volumes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+		if params.Branch != nil {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON500 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParsePostDeployResponse parses an HTTP response from a PostDeployWithResponse call
-func ParsePostDeployResponse(rsp *http.Response) (*PostDeployResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostDeployResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Deployments
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		if params.XAPIKey != nil {
+			var headerParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+			req.Header.Set("X-API-Key", headerParam0)
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+// NewDeleteProjectsNameVolumesVolumeRequest generates requests for DeleteProjectsNameVolumesVolume
+func NewDeleteProjectsNameVolumesVolumeRequest(server string, name string, volume string, params *DeleteProjectsNameVolumesVolumeParams) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "volume", runtime.ParamLocationPath, volume)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHealthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	operationPath := fmt.Sprintf("/projects/%s/volumes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// ParseGetOpenapiYamlResponse parses an HTTP response from a GetOpenapiYamlWithResponse call
-func ParseGetOpenapiYamlResponse(rsp *http.Response) (*GetOpenapiYamlResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOpenapiYamlResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+		if params.Branch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest string
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.YAML200 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseGetProjectsResponse parses an HTTP response from a GetProjectsWithResponse call
-func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Projects *[]Project `json:"projects,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		if params.XAPIKey != nil {
+			var headerParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+			req.Header.Set("X-API-Key", headerParam0)
 		}
-		response.JSON500 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParsePostProjectsResponse parses an HTTP response from a PostProjectsWithResponse call
-func ParsePostProjectsResponse(rsp *http.Response) (*PostProjectsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetProjectsNameVolumesVolumeArchiveRequest generates requests for GetProjectsNameVolumesVolumeArchive
+func NewGetProjectsNameVolumesVolumeArchiveRequest(server string, name string, volume string, params *GetProjectsNameVolumesVolumeArchiveParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostProjectsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "volume", runtime.ParamLocationPath, volume)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Project
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	operationPath := fmt.Sprintf("/projects/%s/volumes/%s/archive", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Branch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Path != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON500 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseDeleteProjectsNameResponse parses an HTTP response from a DeleteProjectsNameWithResponse call
-func ParseDeleteProjectsNameResponse(rsp *http.Response) (*DeleteProjectsNameResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectsNameResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-API-Key", headerParam0)
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	return req, nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// NewPutProjectsNameVolumesVolumeArchiveRequestWithBody generates requests for PutProjectsNameVolumesVolumeArchive with any type of body
+func NewPutProjectsNameVolumesVolumeArchiveRequestWithBody(server string, name string, volume string, params *PutProjectsNameVolumesVolumeArchiveParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	var pathParam1 string
 
-// ParseGetProjectsNameSecretsResponse parses an HTTP response from a GetProjectsNameSecretsWithResponse call
-func ParseGetProjectsNameSecretsResponse(rsp *http.Response) (*GetProjectsNameSecretsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "volume", runtime.ParamLocationPath, volume)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectsNameSecretsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			union json.RawMessage
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/projects/%s/volumes/%s/archive", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Branch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Path != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-API-Key", headerParam0)
 		}
-		response.JSON500 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParsePutProjectsNameSecretsResponse parses an HTTP response from a PutProjectsNameSecretsWithResponse call
-func ParsePutProjectsNameSecretsResponse(rsp *http.Response) (*PutProjectsNameSecretsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewPostProjectsNameVolumesVolumeSnapshotsRequest generates requests for PostProjectsNameVolumesVolumeSnapshots
+func NewPostProjectsNameVolumesVolumeSnapshotsRequest(server string, name string, volume string, params *PostProjectsNameVolumesVolumeSnapshotsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutProjectsNameSecretsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "volume", runtime.ParamLocationPath, volume)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	operationPath := fmt.Sprintf("/projects/%s/volumes/%s/snapshots", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Branch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON500 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseGetServicesResponse parses an HTTP response from a GetServicesWithResponse call
-func ParseGetServicesResponse(rsp *http.Response) (*GetServicesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetServicesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Services *[]ServiceListItem `json:"services,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		if params.XAPIKey != nil {
+			var headerParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+			req.Header.Set("X-API-Key", headerParam0)
 		}
-		response.JSON500 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetServicesNameResponse parses an HTTP response from a GetServicesNameWithResponse call
-func ParseGetServicesNameResponse(rsp *http.Response) (*GetServicesNameResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetServicesRequest generates requests for GetServices
+func NewGetServicesRequest(server string, params *GetServicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetServicesNameResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/services")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ServiceDetail
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-API-Key", headerParam0)
 		}
-		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+	}
+
+	return req, nil
+}
+
+// NewGetServicesNameRequest generates requests for GetServicesName
+func NewGetServicesNameRequest(server string, name string, params *GetServicesNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/services/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project", runtime.ParamLocationQuery, params.Project); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Branch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-API-Key", headerParam0)
 		}
-		response.JSON500 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetServicesNameLogsResponse parses an HTTP response from a GetServicesNameLogsWithResponse call
-func ParseGetServicesNameLogsResponse(rsp *http.Response) (*GetServicesNameLogsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetServicesNameLogsRequest generates requests for GetServicesNameLogs
+func NewGetServicesNameLogsRequest(server string, name string, params *GetServicesNameLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetServicesNameLogsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	operationPath := fmt.Sprintf("/services/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project", runtime.ParamLocationQuery, params.Project); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		if params.Branch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "branch", runtime.ParamLocationQuery, *params.Branch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XAPIKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-API-Key", runtime.ParamLocationHeader, *params.XAPIKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-API-Key", headerParam0)
 		}
-		response.JSON500 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Delete a branch
-	// (DELETE /branch)
-	DeleteBranch(w http.ResponseWriter, r *http.Request, params DeleteBranchParams)
-	// Deploy a project
-	// (POST /deploy)
-	PostDeploy(w http.ResponseWriter, r *http.Request, params PostDeployParams)
-	// Health check
-	// (GET /health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
-	// Get OpenAPI specification.
-	// (GET /openapi.yaml)
-	GetOpenapiYaml(w http.ResponseWriter, r *http.Request)
-	// List all projects
-	// (GET /projects)
-	GetProjects(w http.ResponseWriter, r *http.Request, params GetProjectsParams)
-	// Create a new project
-	// (POST /projects)
-	PostProjects(w http.ResponseWriter, r *http.Request, params PostProjectsParams)
-	// Delete a project
-	// (DELETE /projects/{name})
-	DeleteProjectsName(w http.ResponseWriter, r *http.Request, name string, params DeleteProjectsNameParams)
-	// Get project secrets
-	// (GET /projects/{name}/secrets)
-	GetProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameSecretsParams)
-	// Update project secrets
-	// (PUT /projects/{name}/secrets)
-	PutProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params PutProjectsNameSecretsParams)
-	// List all services
-	// (GET /services)
-	GetServices(w http.ResponseWriter, r *http.Request, params GetServicesParams)
-	// Get service details
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// DeleteBranchWithResponse request
+	DeleteBranchWithResponse(ctx context.Context, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*DeleteBranchResponse, error)
+
+	// PostDeployWithBodyWithResponse request with any body
+	PostDeployWithBodyWithResponse(ctx context.Context, params *PostDeployParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeployResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetOpenapiYamlWithResponse request
+	GetOpenapiYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenapiYamlResponse, error)
+
+	// GetProjectsWithResponse request
+	GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error)
+
+	// PostProjectsWithBodyWithResponse request with any body
+	PostProjectsWithBodyWithResponse(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
+
+	PostProjectsWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error)
+
+	// DeleteProjectsNameWithResponse request
+	DeleteProjectsNameWithResponse(ctx context.Context, name string, params *DeleteProjectsNameParams, reqEditors ...RequestEditorFn) (*DeleteProjectsNameResponse, error)
+
+	// GetProjectsNameCertificatesWithResponse request
+	GetProjectsNameCertificatesWithResponse(ctx context.Context, name string, params *GetProjectsNameCertificatesParams, reqEditors ...RequestEditorFn) (*GetProjectsNameCertificatesResponse, error)
+
+	// PostProjectsNameCertificatesWithBodyWithResponse request with any body
+	PostProjectsNameCertificatesWithBodyWithResponse(ctx context.Context, name string, params *PostProjectsNameCertificatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsNameCertificatesResponse, error)
+
+	PostProjectsNameCertificatesWithResponse(ctx context.Context, name string, params *PostProjectsNameCertificatesParams, body PostProjectsNameCertificatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsNameCertificatesResponse, error)
+
+	// PutProjectsNameProtectionWithBodyWithResponse request with any body
+	PutProjectsNameProtectionWithBodyWithResponse(ctx context.Context, name string, params *PutProjectsNameProtectionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutProjectsNameProtectionResponse, error)
+
+	PutProjectsNameProtectionWithResponse(ctx context.Context, name string, params *PutProjectsNameProtectionParams, body PutProjectsNameProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutProjectsNameProtectionResponse, error)
+
+	// GetProjectsNameSecretsWithResponse request
+	GetProjectsNameSecretsWithResponse(ctx context.Context, name string, params *GetProjectsNameSecretsParams, reqEditors ...RequestEditorFn) (*GetProjectsNameSecretsResponse, error)
+
+	// PatchProjectsNameSecretsWithBodyWithResponse request with any body
+	PatchProjectsNameSecretsWithBodyWithResponse(ctx context.Context, name string, params *PatchProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectsNameSecretsResponse, error)
+
+	PatchProjectsNameSecretsWithResponse(ctx context.Context, name string, params *PatchProjectsNameSecretsParams, body PatchProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsNameSecretsResponse, error)
+
+	// PutProjectsNameSecretsWithBodyWithResponse request with any body
+	PutProjectsNameSecretsWithBodyWithResponse(ctx context.Context, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutProjectsNameSecretsResponse, error)
+
+	PutProjectsNameSecretsWithResponse(ctx context.Context, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutProjectsNameSecretsResponse, error)
+
+	// GetProjectsNameSecretsVersionsWithResponse request
+	GetProjectsNameSecretsVersionsWithResponse(ctx context.Context, name string, params *GetProjectsNameSecretsVersionsParams, reqEditors ...RequestEditorFn) (*GetProjectsNameSecretsVersionsResponse, error)
+
+	// PostProjectsNameSecretsVersionsVersionRollbackWithResponse request
+	PostProjectsNameSecretsVersionsVersionRollbackWithResponse(ctx context.Context, name string, version int32, params *PostProjectsNameSecretsVersionsVersionRollbackParams, reqEditors ...RequestEditorFn) (*PostProjectsNameSecretsVersionsVersionRollbackResponse, error)
+
+	// GetProjectsNameSnapshotsWithResponse request
+	GetProjectsNameSnapshotsWithResponse(ctx context.Context, name string, params *GetProjectsNameSnapshotsParams, reqEditors ...RequestEditorFn) (*GetProjectsNameSnapshotsResponse, error)
+
+	// PostProjectsNameSnapshotsSnapshotRestoreWithResponse request
+	PostProjectsNameSnapshotsSnapshotRestoreWithResponse(ctx context.Context, name string, snapshot openapi_types.UUID, params *PostProjectsNameSnapshotsSnapshotRestoreParams, reqEditors ...RequestEditorFn) (*PostProjectsNameSnapshotsSnapshotRestoreResponse, error)
+
+	// GetProjectsNameVolumesWithResponse request
+	GetProjectsNameVolumesWithResponse(ctx context.Context, name string, params *GetProjectsNameVolumesParams, reqEditors ...RequestEditorFn) (*GetProjectsNameVolumesResponse, error)
+
+	// DeleteProjectsNameVolumesVolumeWithResponse request
+	DeleteProjectsNameVolumesVolumeWithResponse(ctx context.Context, name string, volume string, params *DeleteProjectsNameVolumesVolumeParams, reqEditors ...RequestEditorFn) (*DeleteProjectsNameVolumesVolumeResponse, error)
+
+	// GetProjectsNameVolumesVolumeArchiveWithResponse request
+	GetProjectsNameVolumesVolumeArchiveWithResponse(ctx context.Context, name string, volume string, params *GetProjectsNameVolumesVolumeArchiveParams, reqEditors ...RequestEditorFn) (*GetProjectsNameVolumesVolumeArchiveResponse, error)
+
+	// PutProjectsNameVolumesVolumeArchiveWithBodyWithResponse request with any body
+	PutProjectsNameVolumesVolumeArchiveWithBodyWithResponse(ctx context.Context, name string, volume string, params *PutProjectsNameVolumesVolumeArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutProjectsNameVolumesVolumeArchiveResponse, error)
+
+	// PostProjectsNameVolumesVolumeSnapshotsWithResponse request
+	PostProjectsNameVolumesVolumeSnapshotsWithResponse(ctx context.Context, name string, volume string, params *PostProjectsNameVolumesVolumeSnapshotsParams, reqEditors ...RequestEditorFn) (*PostProjectsNameVolumesVolumeSnapshotsResponse, error)
+
+	// GetServicesWithResponse request
+	GetServicesWithResponse(ctx context.Context, params *GetServicesParams, reqEditors ...RequestEditorFn) (*GetServicesResponse, error)
+
+	// GetServicesNameWithResponse request
+	GetServicesNameWithResponse(ctx context.Context, name string, params *GetServicesNameParams, reqEditors ...RequestEditorFn) (*GetServicesNameResponse, error)
+
+	// GetServicesNameLogsWithResponse request
+	GetServicesNameLogsWithResponse(ctx context.Context, name string, params *GetServicesNameLogsParams, reqEditors ...RequestEditorFn) (*GetServicesNameLogsResponse, error)
+}
+
+type DeleteBranchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBranchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBranchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDeployResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Deployments
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDeployResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDeployResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenapiYamlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *string
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenapiYamlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenapiYamlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Projects *[]Project `json:"projects,omitempty"`
+	}
+	JSON401 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+	JSON400      *Error
+	JSON401      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectsNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectsNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectsNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsNameCertificatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Certificates *[]Certificate `json:"certificates,omitempty"`
+	}
+	JSON401 *Error
+	JSON403 *Error
+	JSON404 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsNameCertificatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsNameCertificatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostProjectsNameCertificatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Certificate
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProjectsNameCertificatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProjectsNameCertificatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutProjectsNameProtectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutProjectsNameProtectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutProjectsNameProtectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsNameSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		union json.RawMessage
+	}
+	JSON401 *Error
+	JSON403 *Error
+	JSON404 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsNameSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsNameSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchProjectsNameSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretsUpdate
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchProjectsNameSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchProjectsNameSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutProjectsNameSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretsUpdate
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutProjectsNameSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutProjectsNameSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsNameSecretsVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Versions []SecretVersion `json:"versions"`
+	}
+	JSON401 *Error
+	JSON403 *Error
+	JSON404 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsNameSecretsVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsNameSecretsVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostProjectsNameSecretsVersionsVersionRollbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretsRollback
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProjectsNameSecretsVersionsVersionRollbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProjectsNameSecretsVersionsVersionRollbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsNameSnapshotsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Snapshots *[]VolumeSnapshot `json:"snapshots,omitempty"`
+	}
+	JSON401 *Error
+	JSON403 *Error
+	JSON404 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsNameSnapshotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsNameSnapshotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostProjectsNameSnapshotsSnapshotRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VolumeSnapshot
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProjectsNameSnapshotsSnapshotRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProjectsNameSnapshotsSnapshotRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsNameVolumesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Volumes *[]Volume `json:"volumes,omitempty"`
+	}
+	JSON401 *Error
+	JSON403 *Error
+	JSON404 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsNameVolumesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsNameVolumesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectsNameVolumesVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectsNameVolumesVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectsNameVolumesVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectsNameVolumesVolumeArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectsNameVolumesVolumeArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectsNameVolumesVolumeArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutProjectsNameVolumesVolumeArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON422      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutProjectsNameVolumesVolumeArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutProjectsNameVolumesVolumeArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostProjectsNameVolumesVolumeSnapshotsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *VolumeSnapshot
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProjectsNameVolumesVolumeSnapshotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProjectsNameVolumesVolumeSnapshotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetServicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Services *[]ServiceListItem `json:"services,omitempty"`
+	}
+	JSON401 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetServicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetServicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetServicesNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceDetail
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetServicesNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetServicesNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetServicesNameLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetServicesNameLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetServicesNameLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// DeleteBranchWithResponse request returning *DeleteBranchResponse
+func (c *ClientWithResponses) DeleteBranchWithResponse(ctx context.Context, params *DeleteBranchParams, reqEditors ...RequestEditorFn) (*DeleteBranchResponse, error) {
+	rsp, err := c.DeleteBranch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBranchResponse(rsp)
+}
+
+// PostDeployWithBodyWithResponse request with arbitrary body returning *PostDeployResponse
+func (c *ClientWithResponses) PostDeployWithBodyWithResponse(ctx context.Context, params *PostDeployParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeployResponse, error) {
+	rsp, err := c.PostDeployWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeployResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetOpenapiYamlWithResponse request returning *GetOpenapiYamlResponse
+func (c *ClientWithResponses) GetOpenapiYamlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenapiYamlResponse, error) {
+	rsp, err := c.GetOpenapiYaml(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenapiYamlResponse(rsp)
+}
+
+// GetProjectsWithResponse request returning *GetProjectsResponse
+func (c *ClientWithResponses) GetProjectsWithResponse(ctx context.Context, params *GetProjectsParams, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error) {
+	rsp, err := c.GetProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsResponse(rsp)
+}
+
+// PostProjectsWithBodyWithResponse request with arbitrary body returning *PostProjectsResponse
+func (c *ClientWithResponses) PostProjectsWithBodyWithResponse(ctx context.Context, params *PostProjectsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
+	rsp, err := c.PostProjectsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProjectsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostProjectsWithResponse(ctx context.Context, params *PostProjectsParams, body PostProjectsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsResponse, error) {
+	rsp, err := c.PostProjects(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProjectsResponse(rsp)
+}
+
+// DeleteProjectsNameWithResponse request returning *DeleteProjectsNameResponse
+func (c *ClientWithResponses) DeleteProjectsNameWithResponse(ctx context.Context, name string, params *DeleteProjectsNameParams, reqEditors ...RequestEditorFn) (*DeleteProjectsNameResponse, error) {
+	rsp, err := c.DeleteProjectsName(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectsNameResponse(rsp)
+}
+
+// GetProjectsNameCertificatesWithResponse request returning *GetProjectsNameCertificatesResponse
+func (c *ClientWithResponses) GetProjectsNameCertificatesWithResponse(ctx context.Context, name string, params *GetProjectsNameCertificatesParams, reqEditors ...RequestEditorFn) (*GetProjectsNameCertificatesResponse, error) {
+	rsp, err := c.GetProjectsNameCertificates(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsNameCertificatesResponse(rsp)
+}
+
+// PostProjectsNameCertificatesWithBodyWithResponse request with arbitrary body returning *PostProjectsNameCertificatesResponse
+func (c *ClientWithResponses) PostProjectsNameCertificatesWithBodyWithResponse(ctx context.Context, name string, params *PostProjectsNameCertificatesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProjectsNameCertificatesResponse, error) {
+	rsp, err := c.PostProjectsNameCertificatesWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProjectsNameCertificatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostProjectsNameCertificatesWithResponse(ctx context.Context, name string, params *PostProjectsNameCertificatesParams, body PostProjectsNameCertificatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProjectsNameCertificatesResponse, error) {
+	rsp, err := c.PostProjectsNameCertificates(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProjectsNameCertificatesResponse(rsp)
+}
+
+// PutProjectsNameProtectionWithBodyWithResponse request with arbitrary body returning *PutProjectsNameProtectionResponse
+func (c *ClientWithResponses) PutProjectsNameProtectionWithBodyWithResponse(ctx context.Context, name string, params *PutProjectsNameProtectionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutProjectsNameProtectionResponse, error) {
+	rsp, err := c.PutProjectsNameProtectionWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutProjectsNameProtectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutProjectsNameProtectionWithResponse(ctx context.Context, name string, params *PutProjectsNameProtectionParams, body PutProjectsNameProtectionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutProjectsNameProtectionResponse, error) {
+	rsp, err := c.PutProjectsNameProtection(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutProjectsNameProtectionResponse(rsp)
+}
+
+// GetProjectsNameSecretsWithResponse request returning *GetProjectsNameSecretsResponse
+func (c *ClientWithResponses) GetProjectsNameSecretsWithResponse(ctx context.Context, name string, params *GetProjectsNameSecretsParams, reqEditors ...RequestEditorFn) (*GetProjectsNameSecretsResponse, error) {
+	rsp, err := c.GetProjectsNameSecrets(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsNameSecretsResponse(rsp)
+}
+
+// PatchProjectsNameSecretsWithBodyWithResponse request with arbitrary body returning *PatchProjectsNameSecretsResponse
+func (c *ClientWithResponses) PatchProjectsNameSecretsWithBodyWithResponse(ctx context.Context, name string, params *PatchProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchProjectsNameSecretsResponse, error) {
+	rsp, err := c.PatchProjectsNameSecretsWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectsNameSecretsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchProjectsNameSecretsWithResponse(ctx context.Context, name string, params *PatchProjectsNameSecretsParams, body PatchProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchProjectsNameSecretsResponse, error) {
+	rsp, err := c.PatchProjectsNameSecrets(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchProjectsNameSecretsResponse(rsp)
+}
+
+// PutProjectsNameSecretsWithBodyWithResponse request with arbitrary body returning *PutProjectsNameSecretsResponse
+func (c *ClientWithResponses) PutProjectsNameSecretsWithBodyWithResponse(ctx context.Context, name string, params *PutProjectsNameSecretsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutProjectsNameSecretsResponse, error) {
+	rsp, err := c.PutProjectsNameSecretsWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutProjectsNameSecretsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutProjectsNameSecretsWithResponse(ctx context.Context, name string, params *PutProjectsNameSecretsParams, body PutProjectsNameSecretsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutProjectsNameSecretsResponse, error) {
+	rsp, err := c.PutProjectsNameSecrets(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutProjectsNameSecretsResponse(rsp)
+}
+
+// GetProjectsNameSecretsVersionsWithResponse request returning *GetProjectsNameSecretsVersionsResponse
+func (c *ClientWithResponses) GetProjectsNameSecretsVersionsWithResponse(ctx context.Context, name string, params *GetProjectsNameSecretsVersionsParams, reqEditors ...RequestEditorFn) (*GetProjectsNameSecretsVersionsResponse, error) {
+	rsp, err := c.GetProjectsNameSecretsVersions(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsNameSecretsVersionsResponse(rsp)
+}
+
+// PostProjectsNameSecretsVersionsVersionRollbackWithResponse request returning *PostProjectsNameSecretsVersionsVersionRollbackResponse
+func (c *ClientWithResponses) PostProjectsNameSecretsVersionsVersionRollbackWithResponse(ctx context.Context, name string, version int32, params *PostProjectsNameSecretsVersionsVersionRollbackParams, reqEditors ...RequestEditorFn) (*PostProjectsNameSecretsVersionsVersionRollbackResponse, error) {
+	rsp, err := c.PostProjectsNameSecretsVersionsVersionRollback(ctx, name, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProjectsNameSecretsVersionsVersionRollbackResponse(rsp)
+}
+
+// GetProjectsNameSnapshotsWithResponse request returning *GetProjectsNameSnapshotsResponse
+func (c *ClientWithResponses) GetProjectsNameSnapshotsWithResponse(ctx context.Context, name string, params *GetProjectsNameSnapshotsParams, reqEditors ...RequestEditorFn) (*GetProjectsNameSnapshotsResponse, error) {
+	rsp, err := c.GetProjectsNameSnapshots(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsNameSnapshotsResponse(rsp)
+}
+
+// PostProjectsNameSnapshotsSnapshotRestoreWithResponse request returning *PostProjectsNameSnapshotsSnapshotRestoreResponse
+func (c *ClientWithResponses) PostProjectsNameSnapshotsSnapshotRestoreWithResponse(ctx context.Context, name string, snapshot openapi_types.UUID, params *PostProjectsNameSnapshotsSnapshotRestoreParams, reqEditors ...RequestEditorFn) (*PostProjectsNameSnapshotsSnapshotRestoreResponse, error) {
+	rsp, err := c.PostProjectsNameSnapshotsSnapshotRestore(ctx, name, snapshot, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProjectsNameSnapshotsSnapshotRestoreResponse(rsp)
+}
+
+// GetProjectsNameVolumesWithResponse request returning *GetProjectsNameVolumesResponse
+func (c *ClientWithResponses) GetProjectsNameVolumesWithResponse(ctx context.Context, name string, params *GetProjectsNameVolumesParams, reqEditors ...RequestEditorFn) (*GetProjectsNameVolumesResponse, error) {
+	rsp, err := c.GetProjectsNameVolumes(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsNameVolumesResponse(rsp)
+}
+
+// DeleteProjectsNameVolumesVolumeWithResponse request returning *DeleteProjectsNameVolumesVolumeResponse
+func (c *ClientWithResponses) DeleteProjectsNameVolumesVolumeWithResponse(ctx context.Context, name string, volume string, params *DeleteProjectsNameVolumesVolumeParams, reqEditors ...RequestEditorFn) (*DeleteProjectsNameVolumesVolumeResponse, error) {
+	rsp, err := c.DeleteProjectsNameVolumesVolume(ctx, name, volume, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectsNameVolumesVolumeResponse(rsp)
+}
+
+// GetProjectsNameVolumesVolumeArchiveWithResponse request returning *GetProjectsNameVolumesVolumeArchiveResponse
+func (c *ClientWithResponses) GetProjectsNameVolumesVolumeArchiveWithResponse(ctx context.Context, name string, volume string, params *GetProjectsNameVolumesVolumeArchiveParams, reqEditors ...RequestEditorFn) (*GetProjectsNameVolumesVolumeArchiveResponse, error) {
+	rsp, err := c.GetProjectsNameVolumesVolumeArchive(ctx, name, volume, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectsNameVolumesVolumeArchiveResponse(rsp)
+}
+
+// PutProjectsNameVolumesVolumeArchiveWithBodyWithResponse request with arbitrary body returning *PutProjectsNameVolumesVolumeArchiveResponse
+func (c *ClientWithResponses) PutProjectsNameVolumesVolumeArchiveWithBodyWithResponse(ctx context.Context, name string, volume string, params *PutProjectsNameVolumesVolumeArchiveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutProjectsNameVolumesVolumeArchiveResponse, error) {
+	rsp, err := c.PutProjectsNameVolumesVolumeArchiveWithBody(ctx, name, volume, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutProjectsNameVolumesVolumeArchiveResponse(rsp)
+}
+
+// PostProjectsNameVolumesVolumeSnapshotsWithResponse request returning *PostProjectsNameVolumesVolumeSnapshotsResponse
+func (c *ClientWithResponses) PostProjectsNameVolumesVolumeSnapshotsWithResponse(ctx context.Context, name string, volume string, params *PostProjectsNameVolumesVolumeSnapshotsParams, reqEditors ...RequestEditorFn) (*PostProjectsNameVolumesVolumeSnapshotsResponse, error) {
+	rsp, err := c.PostProjectsNameVolumesVolumeSnapshots(ctx, name, volume, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProjectsNameVolumesVolumeSnapshotsResponse(rsp)
+}
+
+// GetServicesWithResponse request returning *GetServicesResponse
+func (c *ClientWithResponses) GetServicesWithResponse(ctx context.Context, params *GetServicesParams, reqEditors ...RequestEditorFn) (*GetServicesResponse, error) {
+	rsp, err := c.GetServices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetServicesResponse(rsp)
+}
+
+// GetServicesNameWithResponse request returning *GetServicesNameResponse
+func (c *ClientWithResponses) GetServicesNameWithResponse(ctx context.Context, name string, params *GetServicesNameParams, reqEditors ...RequestEditorFn) (*GetServicesNameResponse, error) {
+	rsp, err := c.GetServicesName(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetServicesNameResponse(rsp)
+}
+
+// GetServicesNameLogsWithResponse request returning *GetServicesNameLogsResponse
+func (c *ClientWithResponses) GetServicesNameLogsWithResponse(ctx context.Context, name string, params *GetServicesNameLogsParams, reqEditors ...RequestEditorFn) (*GetServicesNameLogsResponse, error) {
+	rsp, err := c.GetServicesNameLogs(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetServicesNameLogsResponse(rsp)
+}
+
+// ParseDeleteBranchResponse parses an HTTP response from a DeleteBranchWithResponse call
+func ParseDeleteBranchResponse(rsp *http.Response) (*DeleteBranchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBranchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDeployResponse parses an HTTP response from a PostDeployWithResponse call
+func ParsePostDeployResponse(rsp *http.Response) (*PostDeployResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDeployResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Deployments
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOpenapiYamlResponse parses an HTTP response from a GetOpenapiYamlWithResponse call
+func ParseGetOpenapiYamlResponse(rsp *http.Response) (*GetOpenapiYamlResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenapiYamlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsResponse parses an HTTP response from a GetProjectsWithResponse call
+func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Projects *[]Project `json:"projects,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostProjectsResponse parses an HTTP response from a PostProjectsWithResponse call
+func ParsePostProjectsResponse(rsp *http.Response) (*PostProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectsNameResponse parses an HTTP response from a DeleteProjectsNameWithResponse call
+func ParseDeleteProjectsNameResponse(rsp *http.Response) (*DeleteProjectsNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectsNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsNameCertificatesResponse parses an HTTP response from a GetProjectsNameCertificatesWithResponse call
+func ParseGetProjectsNameCertificatesResponse(rsp *http.Response) (*GetProjectsNameCertificatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsNameCertificatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Certificates *[]Certificate `json:"certificates,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostProjectsNameCertificatesResponse parses an HTTP response from a PostProjectsNameCertificatesWithResponse call
+func ParsePostProjectsNameCertificatesResponse(rsp *http.Response) (*PostProjectsNameCertificatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostProjectsNameCertificatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Certificate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutProjectsNameProtectionResponse parses an HTTP response from a PutProjectsNameProtectionWithResponse call
+func ParsePutProjectsNameProtectionResponse(rsp *http.Response) (*PutProjectsNameProtectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutProjectsNameProtectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsNameSecretsResponse parses an HTTP response from a GetProjectsNameSecretsWithResponse call
+func ParseGetProjectsNameSecretsResponse(rsp *http.Response) (*GetProjectsNameSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsNameSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			union json.RawMessage
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchProjectsNameSecretsResponse parses an HTTP response from a PatchProjectsNameSecretsWithResponse call
+func ParsePatchProjectsNameSecretsResponse(rsp *http.Response) (*PatchProjectsNameSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchProjectsNameSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretsUpdate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutProjectsNameSecretsResponse parses an HTTP response from a PutProjectsNameSecretsWithResponse call
+func ParsePutProjectsNameSecretsResponse(rsp *http.Response) (*PutProjectsNameSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutProjectsNameSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretsUpdate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsNameSecretsVersionsResponse parses an HTTP response from a GetProjectsNameSecretsVersionsWithResponse call
+func ParseGetProjectsNameSecretsVersionsResponse(rsp *http.Response) (*GetProjectsNameSecretsVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsNameSecretsVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Versions []SecretVersion `json:"versions"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostProjectsNameSecretsVersionsVersionRollbackResponse parses an HTTP response from a PostProjectsNameSecretsVersionsVersionRollbackWithResponse call
+func ParsePostProjectsNameSecretsVersionsVersionRollbackResponse(rsp *http.Response) (*PostProjectsNameSecretsVersionsVersionRollbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostProjectsNameSecretsVersionsVersionRollbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SecretsRollback
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsNameSnapshotsResponse parses an HTTP response from a GetProjectsNameSnapshotsWithResponse call
+func ParseGetProjectsNameSnapshotsResponse(rsp *http.Response) (*GetProjectsNameSnapshotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsNameSnapshotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Snapshots *[]VolumeSnapshot `json:"snapshots,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostProjectsNameSnapshotsSnapshotRestoreResponse parses an HTTP response from a PostProjectsNameSnapshotsSnapshotRestoreWithResponse call
+func ParsePostProjectsNameSnapshotsSnapshotRestoreResponse(rsp *http.Response) (*PostProjectsNameSnapshotsSnapshotRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostProjectsNameSnapshotsSnapshotRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VolumeSnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsNameVolumesResponse parses an HTTP response from a GetProjectsNameVolumesWithResponse call
+func ParseGetProjectsNameVolumesResponse(rsp *http.Response) (*GetProjectsNameVolumesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsNameVolumesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Volumes *[]Volume `json:"volumes,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectsNameVolumesVolumeResponse parses an HTTP response from a DeleteProjectsNameVolumesVolumeWithResponse call
+func ParseDeleteProjectsNameVolumesVolumeResponse(rsp *http.Response) (*DeleteProjectsNameVolumesVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectsNameVolumesVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectsNameVolumesVolumeArchiveResponse parses an HTTP response from a GetProjectsNameVolumesVolumeArchiveWithResponse call
+func ParseGetProjectsNameVolumesVolumeArchiveResponse(rsp *http.Response) (*GetProjectsNameVolumesVolumeArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectsNameVolumesVolumeArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutProjectsNameVolumesVolumeArchiveResponse parses an HTTP response from a PutProjectsNameVolumesVolumeArchiveWithResponse call
+func ParsePutProjectsNameVolumesVolumeArchiveResponse(rsp *http.Response) (*PutProjectsNameVolumesVolumeArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutProjectsNameVolumesVolumeArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostProjectsNameVolumesVolumeSnapshotsResponse parses an HTTP response from a PostProjectsNameVolumesVolumeSnapshotsWithResponse call
+func ParsePostProjectsNameVolumesVolumeSnapshotsResponse(rsp *http.Response) (*PostProjectsNameVolumesVolumeSnapshotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostProjectsNameVolumesVolumeSnapshotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VolumeSnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetServicesResponse parses an HTTP response from a GetServicesWithResponse call
+func ParseGetServicesResponse(rsp *http.Response) (*GetServicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetServicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Services *[]ServiceListItem `json:"services,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetServicesNameResponse parses an HTTP response from a GetServicesNameWithResponse call
+func ParseGetServicesNameResponse(rsp *http.Response) (*GetServicesNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetServicesNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServiceDetail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetServicesNameLogsResponse parses an HTTP response from a GetServicesNameLogsWithResponse call
+func ParseGetServicesNameLogsResponse(rsp *http.Response) (*GetServicesNameLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetServicesNameLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Delete a branch
+	// (DELETE /branch)
+	DeleteBranch(w http.ResponseWriter, r *http.Request, params DeleteBranchParams)
+	// Deploy a project
+	// (POST /deploy)
+	PostDeploy(w http.ResponseWriter, r *http.Request, params PostDeployParams)
+	// Health check
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Get OpenAPI specification.
+	// (GET /openapi.yaml)
+	GetOpenapiYaml(w http.ResponseWriter, r *http.Request)
+	// List all projects
+	// (GET /projects)
+	GetProjects(w http.ResponseWriter, r *http.Request, params GetProjectsParams)
+	// Create a new project
+	// (POST /projects)
+	PostProjects(w http.ResponseWriter, r *http.Request, params PostProjectsParams)
+	// Delete a project
+	// (DELETE /projects/{name})
+	DeleteProjectsName(w http.ResponseWriter, r *http.Request, name string, params DeleteProjectsNameParams)
+	// List uploaded certificates
+	// (GET /projects/{name}/certificates)
+	GetProjectsNameCertificates(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameCertificatesParams)
+	// Upload a certificate
+	// (POST /projects/{name}/certificates)
+	PostProjectsNameCertificates(w http.ResponseWriter, r *http.Request, name string, params PostProjectsNameCertificatesParams)
+	// Configure preview protection
+	// (PUT /projects/{name}/protection)
+	PutProjectsNameProtection(w http.ResponseWriter, r *http.Request, name string, params PutProjectsNameProtectionParams)
+	// Get project secrets
+	// (GET /projects/{name}/secrets)
+	GetProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameSecretsParams)
+	// Change project secrets
+	// (PATCH /projects/{name}/secrets)
+	PatchProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params PatchProjectsNameSecretsParams)
+	// Update project secrets
+	// (PUT /projects/{name}/secrets)
+	PutProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params PutProjectsNameSecretsParams)
+	// Get secret history
+	// (GET /projects/{name}/secrets/versions)
+	GetProjectsNameSecretsVersions(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameSecretsVersionsParams)
+	// Roll back secrets
+	// (POST /projects/{name}/secrets/versions/{version}/rollback)
+	PostProjectsNameSecretsVersionsVersionRollback(w http.ResponseWriter, r *http.Request, name string, version int32, params PostProjectsNameSecretsVersionsVersionRollbackParams)
+	// List snapshots
+	// (GET /projects/{name}/snapshots)
+	GetProjectsNameSnapshots(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameSnapshotsParams)
+	// Restore a snapshot
+	// (POST /projects/{name}/snapshots/{snapshot}/restore)
+	PostProjectsNameSnapshotsSnapshotRestore(w http.ResponseWriter, r *http.Request, name string, snapshot openapi_types.UUID, params PostProjectsNameSnapshotsSnapshotRestoreParams)
+	// List volumes
+	// (GET /projects/{name}/volumes)
+	GetProjectsNameVolumes(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameVolumesParams)
+	// Delete a volume
+	// (DELETE /projects/{name}/volumes/{volume})
+	DeleteProjectsNameVolumesVolume(w http.ResponseWriter, r *http.Request, name string, volume string, params DeleteProjectsNameVolumesVolumeParams)
+	// Download volume contents
+	// (GET /projects/{name}/volumes/{volume}/archive)
+	GetProjectsNameVolumesVolumeArchive(w http.ResponseWriter, r *http.Request, name string, volume string, params GetProjectsNameVolumesVolumeArchiveParams)
+	// Upload volume contents
+	// (PUT /projects/{name}/volumes/{volume}/archive)
+	PutProjectsNameVolumesVolumeArchive(w http.ResponseWriter, r *http.Request, name string, volume string, params PutProjectsNameVolumesVolumeArchiveParams)
+	// Snapshot a volume
+	// (POST /projects/{name}/volumes/{volume}/snapshots)
+	PostProjectsNameVolumesVolumeSnapshots(w http.ResponseWriter, r *http.Request, name string, volume string, params PostProjectsNameVolumesVolumeSnapshotsParams)
+	// List all services
+	// (GET /services)
+	GetServices(w http.ResponseWriter, r *http.Request, params GetServicesParams)
+	// Get service details
 	// (GET /services/{name})
 	GetServicesName(w http.ResponseWriter, r *http.Request, name string, params GetServicesNameParams)
 	// Stream service logs
@@ -2313,1461 +5272,3644 @@ type ServerInterface interface {
 	GetServicesNameLogs(w http.ResponseWriter, r *http.Request, name string, params GetServicesNameLogsParams)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// DeleteBranch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBranch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBranchParams
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "branch" -------------
+
+	if paramValue := r.URL.Query().Get("branch"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "branch"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBranch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostDeploy operation middleware
+func (siw *ServerInterfaceWrapper) PostDeploy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostDeployParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostDeploy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOpenapiYaml operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenapiYaml(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOpenapiYaml(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjects operation middleware
+func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjects(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProjects operation middleware
+func (siw *ServerInterfaceWrapper) PostProjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProjectsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProjects(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectsName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectsName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProjectsNameParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectsName(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectsNameCertificates operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsNameCertificates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsNameCertificatesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectsNameCertificates(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProjectsNameCertificates operation middleware
+func (siw *ServerInterfaceWrapper) PostProjectsNameCertificates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProjectsNameCertificatesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProjectsNameCertificates(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutProjectsNameProtection operation middleware
+func (siw *ServerInterfaceWrapper) PutProjectsNameProtection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutProjectsNameProtectionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutProjectsNameProtection(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectsNameSecrets operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsNameSecrets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsNameSecretsParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "values" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "values", r.URL.Query(), &params.Values)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "values", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectsNameSecrets(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchProjectsNameSecrets operation middleware
+func (siw *ServerInterfaceWrapper) PatchProjectsNameSecrets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchProjectsNameSecretsParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "restart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "restart", r.URL.Query(), &params.Restart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "restart", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchProjectsNameSecrets(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutProjectsNameSecrets operation middleware
+func (siw *ServerInterfaceWrapper) PutProjectsNameSecrets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutProjectsNameSecretsParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "restart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "restart", r.URL.Query(), &params.Restart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "restart", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutProjectsNameSecrets(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectsNameSecretsVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsNameSecretsVersions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsNameSecretsVersionsParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectsNameSecretsVersions(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProjectsNameSecretsVersionsVersionRollback operation middleware
+func (siw *ServerInterfaceWrapper) PostProjectsNameSecretsVersionsVersionRollback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", mux.Vars(r)["version"], &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProjectsNameSecretsVersionsVersionRollbackParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "restart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "restart", r.URL.Query(), &params.Restart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "restart", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProjectsNameSecretsVersionsVersionRollback(w, r, name, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectsNameSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsNameSnapshots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsNameSnapshotsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectsNameSnapshots(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProjectsNameSnapshotsSnapshotRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostProjectsNameSnapshotsSnapshotRestore(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "snapshot" -------------
+	var snapshot openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshot", mux.Vars(r)["snapshot"], &snapshot, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshot", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProjectsNameSnapshotsSnapshotRestoreParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProjectsNameSnapshotsSnapshotRestore(w, r, name, snapshot, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectsNameVolumes operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsNameVolumes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsNameVolumesParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectsNameVolumes(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectsNameVolumesVolume operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectsNameVolumesVolume(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "volume" -------------
+	var volume string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volume", mux.Vars(r)["volume"], &volume, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volume", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProjectsNameVolumesVolumeParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectsNameVolumesVolume(w, r, name, volume, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectsNameVolumesVolumeArchive operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectsNameVolumesVolumeArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "volume" -------------
+	var volume string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volume", mux.Vars(r)["volume"], &volume, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volume", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectsNameVolumesVolumeArchiveParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectsNameVolumesVolumeArchive(w, r, name, volume, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutProjectsNameVolumesVolumeArchive operation middleware
+func (siw *ServerInterfaceWrapper) PutProjectsNameVolumesVolumeArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "volume" -------------
+	var volume string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volume", mux.Vars(r)["volume"], &volume, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volume", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutProjectsNameVolumesVolumeArchiveParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutProjectsNameVolumesVolumeArchive(w, r, name, volume, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProjectsNameVolumesVolumeSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) PostProjectsNameVolumesVolumeSnapshots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "volume" -------------
+	var volume string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "volume", mux.Vars(r)["volume"], &volume, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "volume", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProjectsNameVolumesVolumeSnapshotsParams
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProjectsNameVolumesVolumeSnapshots(w, r, name, volume, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServices operation middleware
+func (siw *ServerInterfaceWrapper) GetServices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServicesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServicesName operation middleware
+func (siw *ServerInterfaceWrapper) GetServicesName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServicesNameParams
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServicesName(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServicesNameLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetServicesNameLogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServicesNameLogsParams
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "branch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-API-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
+		var XAPIKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
+			return
+		}
+
+		params.XAPIKey = &XAPIKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServicesNameLogs(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/branch", wrapper.DeleteBranch).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/deploy", wrapper.PostDeploy).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/health", wrapper.GetHealth).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/openapi.yaml", wrapper.GetOpenapiYaml).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects", wrapper.GetProjects).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects", wrapper.PostProjects).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}", wrapper.DeleteProjectsName).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/certificates", wrapper.GetProjectsNameCertificates).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/certificates", wrapper.PostProjectsNameCertificates).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/protection", wrapper.PutProjectsNameProtection).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/secrets", wrapper.GetProjectsNameSecrets).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/secrets", wrapper.PatchProjectsNameSecrets).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/secrets", wrapper.PutProjectsNameSecrets).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/secrets/versions", wrapper.GetProjectsNameSecretsVersions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/secrets/versions/{version}/rollback", wrapper.PostProjectsNameSecretsVersionsVersionRollback).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/snapshots", wrapper.GetProjectsNameSnapshots).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/snapshots/{snapshot}/restore", wrapper.PostProjectsNameSnapshotsSnapshotRestore).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/volumes", wrapper.GetProjectsNameVolumes).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/volumes/{volume}", wrapper.DeleteProjectsNameVolumesVolume).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/volumes/{volume}/archive", wrapper.GetProjectsNameVolumesVolumeArchive).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/volumes/{volume}/archive", wrapper.PutProjectsNameVolumesVolumeArchive).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/projects/{name}/volumes/{volume}/snapshots", wrapper.PostProjectsNameVolumesVolumeSnapshots).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/services", wrapper.GetServices).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/services/{name}", wrapper.GetServicesName).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/services/{name}/logs", wrapper.GetServicesNameLogs).Methods("GET")
+
+	return r
+}
+
+type DeleteBranchRequestObject struct {
+	Params DeleteBranchParams
+}
+
+type DeleteBranchResponseObject interface {
+	VisitDeleteBranchResponse(w http.ResponseWriter) error
+}
+
+type DeleteBranch204Response struct {
+}
+
+func (response DeleteBranch204Response) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBranch400JSONResponse Error
+
+func (response DeleteBranch400JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBranch401JSONResponse Error
+
+func (response DeleteBranch401JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBranch403JSONResponse Error
+
+func (response DeleteBranch403JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBranch404JSONResponse Error
+
+func (response DeleteBranch404JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBranch500JSONResponse Error
+
+func (response DeleteBranch500JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeployRequestObject struct {
+	Params PostDeployParams
+	Body   *multipart.Reader
+}
+
+type PostDeployResponseObject interface {
+	VisitPostDeployResponse(w http.ResponseWriter) error
+}
+
+type PostDeploy200JSONResponse Deployments
+
+func (response PostDeploy200JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeploy400JSONResponse Error
+
+func (response PostDeploy400JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeploy401JSONResponse Error
+
+func (response PostDeploy401JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeploy403JSONResponse Error
+
+func (response PostDeploy403JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeploy404JSONResponse Error
+
+func (response PostDeploy404JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeploy409JSONResponse Error
+
+func (response PostDeploy409JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeploy422JSONResponse Error
+
+func (response PostDeploy422JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeploy500JSONResponse Error
+
+func (response PostDeploy500JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth204Response struct {
+}
+
+func (response GetHealth204Response) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GetOpenapiYamlRequestObject struct {
+}
+
+type GetOpenapiYamlResponseObject interface {
+	VisitGetOpenapiYamlResponse(w http.ResponseWriter) error
+}
+
+type GetOpenapiYaml200ApplicationxYamlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetOpenapiYaml200ApplicationxYamlResponse) VisitGetOpenapiYamlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetOpenapiYaml500JSONResponse Error
+
+func (response GetOpenapiYaml500JSONResponse) VisitGetOpenapiYamlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectsRequestObject struct {
+	Params GetProjectsParams
+}
+
+type GetProjectsResponseObject interface {
+	VisitGetProjectsResponse(w http.ResponseWriter) error
+}
+
+type GetProjects200JSONResponse struct {
+	Projects *[]Project `json:"projects,omitempty"`
+}
+
+func (response GetProjects200JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjects401JSONResponse Error
+
+func (response GetProjects401JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjects500JSONResponse Error
+
+func (response GetProjects500JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectsRequestObject struct {
+	Params PostProjectsParams
+	Body   *PostProjectsJSONRequestBody
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+type PostProjectsResponseObject interface {
+	VisitPostProjectsResponse(w http.ResponseWriter) error
+}
 
-// DeleteBranch operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBranch(w http.ResponseWriter, r *http.Request) {
+type PostProjects201JSONResponse Project
 
-	var err error
+func (response PostProjects201JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+type PostProjects400JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response PostProjects400JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteBranchParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Required query parameter "project" -------------
+type PostProjects401JSONResponse Error
 
-	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+func (response PostProjects401JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
-		return
-	}
+type PostProjects500JSONResponse Error
 
-	// ------------- Required query parameter "branch" -------------
+func (response PostProjects500JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	if paramValue := r.URL.Query().Get("branch"); paramValue != "" {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "branch"})
-		return
-	}
+type DeleteProjectsNameRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteProjectsNameParams
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "branch", r.URL.Query(), &params.Branch)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
-		return
-	}
+type DeleteProjectsNameResponseObject interface {
+	VisitDeleteProjectsNameResponse(w http.ResponseWriter) error
+}
 
-	headers := r.Header
+type DeleteProjectsName204Response struct {
+}
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+func (response DeleteProjectsName204Response) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+type DeleteProjectsName400JSONResponse Error
 
-		params.XAPIKey = &XAPIKey
+func (response DeleteProjectsName400JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBranch(w, r, params)
-	}))
+type DeleteProjectsName401JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response DeleteProjectsName401JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostDeploy operation middleware
-func (siw *ServerInterfaceWrapper) PostDeploy(w http.ResponseWriter, r *http.Request) {
+type DeleteProjectsName403JSONResponse Error
 
-	var err error
+func (response DeleteProjectsName403JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+type DeleteProjectsName404JSONResponse Error
 
-	r = r.WithContext(ctx)
+func (response DeleteProjectsName404JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostDeployParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	headers := r.Header
+type DeleteProjectsName500JSONResponse Error
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+func (response DeleteProjectsName500JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		params.XAPIKey = &XAPIKey
+type GetProjectsNameCertificatesRequestObject struct {
+	Name   string `json:"name"`
+	Params GetProjectsNameCertificatesParams
+}
 
-	}
+type GetProjectsNameCertificatesResponseObject interface {
+	VisitGetProjectsNameCertificatesResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostDeploy(w, r, params)
-	}))
+type GetProjectsNameCertificates200JSONResponse struct {
+	Certificates *[]Certificate `json:"certificates,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetProjectsNameCertificates200JSONResponse) VisitGetProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+type GetProjectsNameCertificates401JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
-	}))
+func (response GetProjectsNameCertificates401JSONResponse) VisitGetProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type GetProjectsNameCertificates403JSONResponse Error
+
+func (response GetProjectsNameCertificates403JSONResponse) VisitGetProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetOpenapiYaml operation middleware
-func (siw *ServerInterfaceWrapper) GetOpenapiYaml(w http.ResponseWriter, r *http.Request) {
+type GetProjectsNameCertificates404JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOpenapiYaml(w, r)
-	}))
+func (response GetProjectsNameCertificates404JSONResponse) VisitGetProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type GetProjectsNameCertificates500JSONResponse Error
+
+func (response GetProjectsNameCertificates500JSONResponse) VisitGetProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetProjects operation middleware
-func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Request) {
+type PostProjectsNameCertificatesRequestObject struct {
+	Name   string `json:"name"`
+	Params PostProjectsNameCertificatesParams
+	Body   *PostProjectsNameCertificatesJSONRequestBody
+}
 
-	var err error
+type PostProjectsNameCertificatesResponseObject interface {
+	VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error
+}
 
-	ctx := r.Context()
+type PostProjectsNameCertificates201JSONResponse Certificate
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+func (response PostProjectsNameCertificates201JSONResponse) VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProjectsParams
+type PostProjectsNameCertificates400JSONResponse Error
 
-	headers := r.Header
+func (response PostProjectsNameCertificates400JSONResponse) VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+type PostProjectsNameCertificates401JSONResponse Error
 
-		params.XAPIKey = &XAPIKey
+func (response PostProjectsNameCertificates401JSONResponse) VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectsNameCertificates403JSONResponse Error
+
+func (response PostProjectsNameCertificates403JSONResponse) VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectsNameCertificates404JSONResponse Error
+
+func (response PostProjectsNameCertificates404JSONResponse) VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjects(w, r, params)
-	}))
+type PostProjectsNameCertificates409JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response PostProjectsNameCertificates409JSONResponse) VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostProjects operation middleware
-func (siw *ServerInterfaceWrapper) PostProjects(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+type PostProjectsNameCertificates422JSONResponse Error
 
-	ctx := r.Context()
+func (response PostProjectsNameCertificates422JSONResponse) VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type PostProjectsNameCertificates500JSONResponse Error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostProjectsParams
+func (response PostProjectsNameCertificates500JSONResponse) VisitPostProjectsNameCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	headers := r.Header
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+type PutProjectsNameProtectionRequestObject struct {
+	Name   string `json:"name"`
+	Params PutProjectsNameProtectionParams
+	Body   *PutProjectsNameProtectionJSONRequestBody
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+type PutProjectsNameProtectionResponseObject interface {
+	VisitPutProjectsNameProtectionResponse(w http.ResponseWriter) error
+}
 
-		params.XAPIKey = &XAPIKey
+type PutProjectsNameProtection200Response struct {
+}
 
-	}
+func (response PutProjectsNameProtection200Response) VisitPutProjectsNameProtectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostProjects(w, r, params)
-	}))
+type PutProjectsNameProtection400JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response PutProjectsNameProtection400JSONResponse) VisitPutProjectsNameProtectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// DeleteProjectsName operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProjectsName(w http.ResponseWriter, r *http.Request) {
+type PutProjectsNameProtection401JSONResponse Error
 
-	var err error
+func (response PutProjectsNameProtection401JSONResponse) VisitPutProjectsNameProtectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
+type PutProjectsNameProtection403JSONResponse Error
 
-	ctx := r.Context()
+func (response PutProjectsNameProtection403JSONResponse) VisitPutProjectsNameProtectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type PutProjectsNameProtection404JSONResponse Error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteProjectsNameParams
+func (response PutProjectsNameProtection404JSONResponse) VisitPutProjectsNameProtectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	headers := r.Header
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+type PutProjectsNameProtection422JSONResponse Error
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+func (response PutProjectsNameProtection422JSONResponse) VisitPutProjectsNameProtectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
 
-		params.XAPIKey = &XAPIKey
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type PutProjectsNameProtection500JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProjectsName(w, r, name, params)
-	}))
+func (response PutProjectsNameProtection500JSONResponse) VisitPutProjectsNameProtectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type GetProjectsNameSecretsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetProjectsNameSecretsParams
 }
 
-// GetProjectsNameSecrets operation middleware
-func (siw *ServerInterfaceWrapper) GetProjectsNameSecrets(w http.ResponseWriter, r *http.Request) {
+type GetProjectsNameSecretsResponseObject interface {
+	VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type GetProjectsNameSecrets200JSONResponse struct {
+	union json.RawMessage
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+func (response GetProjectsNameSecrets200JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response.union)
+}
 
-	ctx := r.Context()
+type GetProjectsNameSecrets401JSONResponse Error
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+func (response GetProjectsNameSecrets401JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	r = r.WithContext(ctx)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProjectsNameSecretsParams
+type GetProjectsNameSecrets403JSONResponse Error
 
-	// ------------- Optional query parameter "values" -------------
+func (response GetProjectsNameSecrets403JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	err = runtime.BindQueryParameter("form", true, false, "values", r.URL.Query(), &params.Values)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "values", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	headers := r.Header
+type GetProjectsNameSecrets404JSONResponse Error
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+func (response GetProjectsNameSecrets404JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		params.XAPIKey = &XAPIKey
+type GetProjectsNameSecrets500JSONResponse Error
 
-	}
+func (response GetProjectsNameSecrets500JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjectsNameSecrets(w, r, name, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type PatchProjectsNameSecretsRequestObject struct {
+	Name   string `json:"name"`
+	Params PatchProjectsNameSecretsParams
+	Body   *PatchProjectsNameSecretsJSONRequestBody
+}
 
-	handler.ServeHTTP(w, r)
+type PatchProjectsNameSecretsResponseObject interface {
+	VisitPatchProjectsNameSecretsResponse(w http.ResponseWriter) error
 }
 
-// PutProjectsNameSecrets operation middleware
-func (siw *ServerInterfaceWrapper) PutProjectsNameSecrets(w http.ResponseWriter, r *http.Request) {
+type PatchProjectsNameSecrets200JSONResponse SecretsUpdate
 
-	var err error
+func (response PatchProjectsNameSecrets200JSONResponse) VisitPatchProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
+type PatchProjectsNameSecrets400JSONResponse Error
 
-	ctx := r.Context()
+func (response PatchProjectsNameSecrets400JSONResponse) VisitPatchProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type PatchProjectsNameSecrets401JSONResponse Error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PutProjectsNameSecretsParams
+func (response PatchProjectsNameSecrets401JSONResponse) VisitPatchProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	headers := r.Header
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+type PatchProjectsNameSecrets403JSONResponse Error
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+func (response PatchProjectsNameSecrets403JSONResponse) VisitPatchProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-		params.XAPIKey = &XAPIKey
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProjectsNameSecrets404JSONResponse Error
+
+func (response PatchProjectsNameSecrets404JSONResponse) VisitPatchProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutProjectsNameSecrets(w, r, name, params)
-	}))
+type PatchProjectsNameSecrets409JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response PatchProjectsNameSecrets409JSONResponse) VisitPatchProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetServices operation middleware
-func (siw *ServerInterfaceWrapper) GetServices(w http.ResponseWriter, r *http.Request) {
+type PatchProjectsNameSecrets500JSONResponse Error
 
-	var err error
+func (response PatchProjectsNameSecrets500JSONResponse) VisitPatchProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	ctx := r.Context()
+	return json.NewEncoder(w).Encode(response)
+}
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+type PutProjectsNameSecretsRequestObject struct {
+	Name   string `json:"name"`
+	Params PutProjectsNameSecretsParams
+	Body   *PutProjectsNameSecretsJSONRequestBody
+}
 
-	r = r.WithContext(ctx)
+type PutProjectsNameSecretsResponseObject interface {
+	VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetServicesParams
+type PutProjectsNameSecrets200JSONResponse SecretsUpdate
 
-	headers := r.Header
+func (response PutProjectsNameSecrets200JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+type PutProjectsNameSecrets400JSONResponse Error
 
-		params.XAPIKey = &XAPIKey
+func (response PutProjectsNameSecrets400JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServices(w, r, params)
-	}))
+type PutProjectsNameSecrets401JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response PutProjectsNameSecrets401JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetServicesName operation middleware
-func (siw *ServerInterfaceWrapper) GetServicesName(w http.ResponseWriter, r *http.Request) {
+type PutProjectsNameSecrets403JSONResponse Error
 
-	var err error
+func (response PutProjectsNameSecrets403JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
+type PutProjectsNameSecrets404JSONResponse Error
 
-	ctx := r.Context()
+func (response PutProjectsNameSecrets404JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type PutProjectsNameSecrets409JSONResponse Error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetServicesNameParams
+func (response PutProjectsNameSecrets409JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	// ------------- Required query parameter "project" -------------
+	return json.NewEncoder(w).Encode(response)
+}
 
-	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+type PutProjectsNameSecrets500JSONResponse Error
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
-		return
-	}
+func (response PutProjectsNameSecrets500JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "branch" -------------
+type GetProjectsNameSecretsVersionsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetProjectsNameSecretsVersionsParams
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
-		return
-	}
+type GetProjectsNameSecretsVersionsResponseObject interface {
+	VisitGetProjectsNameSecretsVersionsResponse(w http.ResponseWriter) error
+}
 
-	headers := r.Header
+type GetProjectsNameSecretsVersions200JSONResponse struct {
+	Versions []SecretVersion `json:"versions"`
+}
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+func (response GetProjectsNameSecretsVersions200JSONResponse) VisitGetProjectsNameSecretsVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		params.XAPIKey = &XAPIKey
+type GetProjectsNameSecretsVersions401JSONResponse Error
 
-	}
+func (response GetProjectsNameSecretsVersions401JSONResponse) VisitGetProjectsNameSecretsVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServicesName(w, r, name, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetProjectsNameSecretsVersions403JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response GetProjectsNameSecretsVersions403JSONResponse) VisitGetProjectsNameSecretsVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetServicesNameLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetServicesNameLogs(w http.ResponseWriter, r *http.Request) {
+type GetProjectsNameSecretsVersions404JSONResponse Error
 
-	var err error
+func (response GetProjectsNameSecretsVersions404JSONResponse) VisitGetProjectsNameSecretsVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", mux.Vars(r)["name"], &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
+type GetProjectsNameSecretsVersions500JSONResponse Error
 
-	ctx := r.Context()
+func (response GetProjectsNameSecretsVersions500JSONResponse) VisitGetProjectsNameSecretsVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r = r.WithContext(ctx)
+type PostProjectsNameSecretsVersionsVersionRollbackRequestObject struct {
+	Name    string `json:"name"`
+	Version int32  `json:"version"`
+	Params  PostProjectsNameSecretsVersionsVersionRollbackParams
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetServicesNameLogsParams
+type PostProjectsNameSecretsVersionsVersionRollbackResponseObject interface {
+	VisitPostProjectsNameSecretsVersionsVersionRollbackResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Required query parameter "project" -------------
+type PostProjectsNameSecretsVersionsVersionRollback200JSONResponse SecretsRollback
 
-	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+func (response PostProjectsNameSecretsVersionsVersionRollback200JSONResponse) VisitPostProjectsNameSecretsVersionsVersionRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
-		return
-	}
+type PostProjectsNameSecretsVersionsVersionRollback401JSONResponse Error
 
-	// ------------- Optional query parameter "branch" -------------
+func (response PostProjectsNameSecretsVersionsVersionRollback401JSONResponse) VisitPostProjectsNameSecretsVersionsVersionRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "branch", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	headers := r.Header
+type PostProjectsNameSecretsVersionsVersionRollback403JSONResponse Error
 
-	// ------------- Optional header parameter "X-API-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-API-Key")]; found {
-		var XAPIKey string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-API-Key", Count: n})
-			return
-		}
+func (response PostProjectsNameSecretsVersionsVersionRollback403JSONResponse) VisitPostProjectsNameSecretsVersionsVersionRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-API-Key", valueList[0], &XAPIKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-API-Key", Err: err})
-			return
-		}
+	return json.NewEncoder(w).Encode(response)
+}
 
-		params.XAPIKey = &XAPIKey
+type PostProjectsNameSecretsVersionsVersionRollback404JSONResponse Error
 
-	}
+func (response PostProjectsNameSecretsVersionsVersionRollback404JSONResponse) VisitPostProjectsNameSecretsVersionsVersionRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServicesNameLogs(w, r, name, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type PostProjectsNameSecretsVersionsVersionRollback409JSONResponse Error
 
-	handler.ServeHTTP(w, r)
-}
+func (response PostProjectsNameSecretsVersionsVersionRollback409JSONResponse) VisitPostProjectsNameSecretsVersionsVersionRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+type PostProjectsNameSecretsVersionsVersionRollback500JSONResponse Error
+
+func (response PostProjectsNameSecretsVersionsVersionRollback500JSONResponse) VisitPostProjectsNameSecretsVersionsVersionRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type GetProjectsNameSnapshotsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetProjectsNameSnapshotsParams
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+type GetProjectsNameSnapshotsResponseObject interface {
+	VisitGetProjectsNameSnapshotsResponse(w http.ResponseWriter) error
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type GetProjectsNameSnapshots200JSONResponse struct {
+	Snapshots *[]VolumeSnapshot `json:"snapshots,omitempty"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+func (response GetProjectsNameSnapshots200JSONResponse) VisitGetProjectsNameSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-type RequiredParamError struct {
-	ParamName string
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+type GetProjectsNameSnapshots401JSONResponse Error
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+func (response GetProjectsNameSnapshots401JSONResponse) VisitGetProjectsNameSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+type GetProjectsNameSnapshots403JSONResponse Error
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+func (response GetProjectsNameSnapshots403JSONResponse) VisitGetProjectsNameSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+type GetProjectsNameSnapshots404JSONResponse Error
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+func (response GetProjectsNameSnapshots404JSONResponse) VisitGetProjectsNameSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{})
-}
+type GetProjectsNameSnapshots500JSONResponse Error
 
-type GorillaServerOptions struct {
-	BaseURL          string
-	BaseRouter       *mux.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+func (response GetProjectsNameSnapshots500JSONResponse) VisitGetProjectsNameSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseRouter: r,
-	})
+type PostProjectsNameSnapshotsSnapshotRestoreRequestObject struct {
+	Name     string             `json:"name"`
+	Snapshot openapi_types.UUID `json:"snapshot"`
+	Params   PostProjectsNameSnapshotsSnapshotRestoreParams
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type PostProjectsNameSnapshotsSnapshotRestoreResponseObject interface {
+	VisitPostProjectsNameSnapshotsSnapshotRestoreResponse(w http.ResponseWriter) error
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
-	r := options.BaseRouter
+type PostProjectsNameSnapshotsSnapshotRestore200JSONResponse VolumeSnapshot
 
-	if r == nil {
-		r = mux.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response PostProjectsNameSnapshotsSnapshotRestore200JSONResponse) VisitPostProjectsNameSnapshotsSnapshotRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	r.HandleFunc(options.BaseURL+"/branch", wrapper.DeleteBranch).Methods("DELETE")
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r.HandleFunc(options.BaseURL+"/deploy", wrapper.PostDeploy).Methods("POST")
+type PostProjectsNameSnapshotsSnapshotRestore401JSONResponse Error
 
-	r.HandleFunc(options.BaseURL+"/health", wrapper.GetHealth).Methods("GET")
+func (response PostProjectsNameSnapshotsSnapshotRestore401JSONResponse) VisitPostProjectsNameSnapshotsSnapshotRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	r.HandleFunc(options.BaseURL+"/openapi.yaml", wrapper.GetOpenapiYaml).Methods("GET")
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r.HandleFunc(options.BaseURL+"/projects", wrapper.GetProjects).Methods("GET")
+type PostProjectsNameSnapshotsSnapshotRestore403JSONResponse Error
 
-	r.HandleFunc(options.BaseURL+"/projects", wrapper.PostProjects).Methods("POST")
+func (response PostProjectsNameSnapshotsSnapshotRestore403JSONResponse) VisitPostProjectsNameSnapshotsSnapshotRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	r.HandleFunc(options.BaseURL+"/projects/{name}", wrapper.DeleteProjectsName).Methods("DELETE")
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r.HandleFunc(options.BaseURL+"/projects/{name}/secrets", wrapper.GetProjectsNameSecrets).Methods("GET")
+type PostProjectsNameSnapshotsSnapshotRestore404JSONResponse Error
 
-	r.HandleFunc(options.BaseURL+"/projects/{name}/secrets", wrapper.PutProjectsNameSecrets).Methods("PUT")
+func (response PostProjectsNameSnapshotsSnapshotRestore404JSONResponse) VisitPostProjectsNameSnapshotsSnapshotRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	r.HandleFunc(options.BaseURL+"/services", wrapper.GetServices).Methods("GET")
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r.HandleFunc(options.BaseURL+"/services/{name}", wrapper.GetServicesName).Methods("GET")
+type PostProjectsNameSnapshotsSnapshotRestore409JSONResponse Error
 
-	r.HandleFunc(options.BaseURL+"/services/{name}/logs", wrapper.GetServicesNameLogs).Methods("GET")
+func (response PostProjectsNameSnapshotsSnapshotRestore409JSONResponse) VisitPostProjectsNameSnapshotsSnapshotRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
 
-	return r
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBranchRequestObject struct {
-	Params DeleteBranchParams
-}
+type PostProjectsNameSnapshotsSnapshotRestore500JSONResponse Error
 
-type DeleteBranchResponseObject interface {
-	VisitDeleteBranchResponse(w http.ResponseWriter) error
+func (response PostProjectsNameSnapshotsSnapshotRestore500JSONResponse) VisitPostProjectsNameSnapshotsSnapshotRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBranch204Response struct {
+type GetProjectsNameVolumesRequestObject struct {
+	Name   string `json:"name"`
+	Params GetProjectsNameVolumesParams
 }
 
-func (response DeleteBranch204Response) VisitDeleteBranchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetProjectsNameVolumesResponseObject interface {
+	VisitGetProjectsNameVolumesResponse(w http.ResponseWriter) error
 }
 
-type DeleteBranch400JSONResponse Error
+type GetProjectsNameVolumes200JSONResponse struct {
+	Volumes *[]Volume `json:"volumes,omitempty"`
+}
 
-func (response DeleteBranch400JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+func (response GetProjectsNameVolumes200JSONResponse) VisitGetProjectsNameVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBranch401JSONResponse Error
+type GetProjectsNameVolumes401JSONResponse Error
 
-func (response DeleteBranch401JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+func (response GetProjectsNameVolumes401JSONResponse) VisitGetProjectsNameVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBranch403JSONResponse Error
+type GetProjectsNameVolumes403JSONResponse Error
 
-func (response DeleteBranch403JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+func (response GetProjectsNameVolumes403JSONResponse) VisitGetProjectsNameVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBranch404JSONResponse Error
+type GetProjectsNameVolumes404JSONResponse Error
 
-func (response DeleteBranch404JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+func (response GetProjectsNameVolumes404JSONResponse) VisitGetProjectsNameVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteBranch500JSONResponse Error
+type GetProjectsNameVolumes500JSONResponse Error
 
-func (response DeleteBranch500JSONResponse) VisitDeleteBranchResponse(w http.ResponseWriter) error {
+func (response GetProjectsNameVolumes500JSONResponse) VisitGetProjectsNameVolumesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDeployRequestObject struct {
-	Params PostDeployParams
-	Body   *multipart.Reader
+type DeleteProjectsNameVolumesVolumeRequestObject struct {
+	Name   string `json:"name"`
+	Volume string `json:"volume"`
+	Params DeleteProjectsNameVolumesVolumeParams
 }
 
-type PostDeployResponseObject interface {
-	VisitPostDeployResponse(w http.ResponseWriter) error
+type DeleteProjectsNameVolumesVolumeResponseObject interface {
+	VisitDeleteProjectsNameVolumesVolumeResponse(w http.ResponseWriter) error
 }
 
-type PostDeploy200JSONResponse Deployments
+type DeleteProjectsNameVolumesVolume204Response struct {
+}
 
-func (response PostDeploy200JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+func (response DeleteProjectsNameVolumesVolume204Response) VisitDeleteProjectsNameVolumesVolumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProjectsNameVolumesVolume401JSONResponse Error
+
+func (response DeleteProjectsNameVolumesVolume401JSONResponse) VisitDeleteProjectsNameVolumesVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDeploy400JSONResponse Error
+type DeleteProjectsNameVolumesVolume403JSONResponse Error
 
-func (response PostDeploy400JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+func (response DeleteProjectsNameVolumesVolume403JSONResponse) VisitDeleteProjectsNameVolumesVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDeploy401JSONResponse Error
+type DeleteProjectsNameVolumesVolume404JSONResponse Error
 
-func (response PostDeploy401JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+func (response DeleteProjectsNameVolumesVolume404JSONResponse) VisitDeleteProjectsNameVolumesVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDeploy403JSONResponse Error
+type DeleteProjectsNameVolumesVolume409JSONResponse Error
 
-func (response PostDeploy403JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+func (response DeleteProjectsNameVolumesVolume409JSONResponse) VisitDeleteProjectsNameVolumesVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDeploy404JSONResponse Error
+type DeleteProjectsNameVolumesVolume500JSONResponse Error
 
-func (response PostDeploy404JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+func (response DeleteProjectsNameVolumesVolume500JSONResponse) VisitDeleteProjectsNameVolumesVolumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDeploy409JSONResponse Error
+type GetProjectsNameVolumesVolumeArchiveRequestObject struct {
+	Name   string `json:"name"`
+	Volume string `json:"volume"`
+	Params GetProjectsNameVolumesVolumeArchiveParams
+}
 
-func (response PostDeploy409JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+type GetProjectsNameVolumesVolumeArchiveResponseObject interface {
+	VisitGetProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error
+}
+
+type GetProjectsNameVolumesVolumeArchive200ApplicationgzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetProjectsNameVolumesVolumeArchive200ApplicationgzipResponse) VisitGetProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetProjectsNameVolumesVolumeArchive401JSONResponse Error
+
+func (response GetProjectsNameVolumesVolumeArchive401JSONResponse) VisitGetProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDeploy422JSONResponse Error
+type GetProjectsNameVolumesVolumeArchive403JSONResponse Error
 
-func (response PostDeploy422JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+func (response GetProjectsNameVolumesVolumeArchive403JSONResponse) VisitGetProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostDeploy500JSONResponse Error
+type GetProjectsNameVolumesVolumeArchive404JSONResponse Error
 
-func (response PostDeploy500JSONResponse) VisitPostDeployResponse(w http.ResponseWriter) error {
+func (response GetProjectsNameVolumesVolumeArchive404JSONResponse) VisitGetProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectsNameVolumesVolumeArchive500JSONResponse Error
+
+func (response GetProjectsNameVolumesVolumeArchive500JSONResponse) VisitGetProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHealthRequestObject struct {
+type PutProjectsNameVolumesVolumeArchiveRequestObject struct {
+	Name   string `json:"name"`
+	Volume string `json:"volume"`
+	Params PutProjectsNameVolumesVolumeArchiveParams
+	Body   io.Reader
 }
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
+type PutProjectsNameVolumesVolumeArchiveResponseObject interface {
+	VisitPutProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error
 }
 
-type GetHealth204Response struct {
+type PutProjectsNameVolumesVolumeArchive204Response struct {
 }
 
-func (response GetHealth204Response) VisitGetHealthResponse(w http.ResponseWriter) error {
+func (response PutProjectsNameVolumesVolumeArchive204Response) VisitPutProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type GetOpenapiYamlRequestObject struct {
+type PutProjectsNameVolumesVolumeArchive400JSONResponse Error
+
+func (response PutProjectsNameVolumesVolumeArchive400JSONResponse) VisitPutProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOpenapiYamlResponseObject interface {
-	VisitGetOpenapiYamlResponse(w http.ResponseWriter) error
+type PutProjectsNameVolumesVolumeArchive401JSONResponse Error
+
+func (response PutProjectsNameVolumesVolumeArchive401JSONResponse) VisitPutProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOpenapiYaml200ApplicationxYamlResponse struct {
-	Body          io.Reader
-	ContentLength int64
+type PutProjectsNameVolumesVolumeArchive403JSONResponse Error
+
+func (response PutProjectsNameVolumesVolumeArchive403JSONResponse) VisitPutProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProjectsNameVolumesVolumeArchive404JSONResponse Error
+
+func (response PutProjectsNameVolumesVolumeArchive404JSONResponse) VisitPutProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response GetOpenapiYaml200ApplicationxYamlResponse) VisitGetOpenapiYamlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/x-yaml")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
+type PutProjectsNameVolumesVolumeArchive422JSONResponse Error
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+func (response PutProjectsNameVolumesVolumeArchive422JSONResponse) VisitPutProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetOpenapiYaml500JSONResponse Error
+type PutProjectsNameVolumesVolumeArchive500JSONResponse Error
 
-func (response GetOpenapiYaml500JSONResponse) VisitGetOpenapiYamlResponse(w http.ResponseWriter) error {
+func (response PutProjectsNameVolumesVolumeArchive500JSONResponse) VisitPutProjectsNameVolumesVolumeArchiveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectsRequestObject struct {
-	Params GetProjectsParams
+type PostProjectsNameVolumesVolumeSnapshotsRequestObject struct {
+	Name   string `json:"name"`
+	Volume string `json:"volume"`
+	Params PostProjectsNameVolumesVolumeSnapshotsParams
 }
 
-type GetProjectsResponseObject interface {
-	VisitGetProjectsResponse(w http.ResponseWriter) error
+type PostProjectsNameVolumesVolumeSnapshotsResponseObject interface {
+	VisitPostProjectsNameVolumesVolumeSnapshotsResponse(w http.ResponseWriter) error
 }
 
-type GetProjects200JSONResponse struct {
-	Projects *[]Project `json:"projects,omitempty"`
-}
+type PostProjectsNameVolumesVolumeSnapshots201JSONResponse VolumeSnapshot
 
-func (response GetProjects200JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
+func (response PostProjectsNameVolumesVolumeSnapshots201JSONResponse) VisitPostProjectsNameVolumesVolumeSnapshotsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjects401JSONResponse Error
+type PostProjectsNameVolumesVolumeSnapshots401JSONResponse Error
 
-func (response GetProjects401JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
+func (response PostProjectsNameVolumesVolumeSnapshots401JSONResponse) VisitPostProjectsNameVolumesVolumeSnapshotsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjects500JSONResponse Error
+type PostProjectsNameVolumesVolumeSnapshots403JSONResponse Error
 
-func (response GetProjects500JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
+func (response PostProjectsNameVolumesVolumeSnapshots403JSONResponse) VisitPostProjectsNameVolumesVolumeSnapshotsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjectsRequestObject struct {
-	Params PostProjectsParams
-	Body   *PostProjectsJSONRequestBody
-}
+type PostProjectsNameVolumesVolumeSnapshots404JSONResponse Error
 
-type PostProjectsResponseObject interface {
-	VisitPostProjectsResponse(w http.ResponseWriter) error
+func (response PostProjectsNameVolumesVolumeSnapshots404JSONResponse) VisitPostProjectsNameVolumesVolumeSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjects201JSONResponse Project
+type PostProjectsNameVolumesVolumeSnapshots500JSONResponse Error
 
-func (response PostProjects201JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+func (response PostProjectsNameVolumesVolumeSnapshots500JSONResponse) VisitPostProjectsNameVolumesVolumeSnapshotsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjects400JSONResponse Error
+type GetServicesRequestObject struct {
+	Params GetServicesParams
+}
 
-func (response PostProjects400JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+type GetServicesResponseObject interface {
+	VisitGetServicesResponse(w http.ResponseWriter) error
+}
+
+type GetServices200JSONResponse struct {
+	Services *[]ServiceListItem `json:"services,omitempty"`
+}
+
+func (response GetServices200JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjects401JSONResponse Error
+type GetServices401JSONResponse Error
 
-func (response PostProjects401JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+func (response GetServices401JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostProjects500JSONResponse Error
+type GetServices500JSONResponse Error
 
-func (response PostProjects500JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+func (response GetServices500JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectsNameRequestObject struct {
+type GetServicesNameRequestObject struct {
 	Name   string `json:"name"`
-	Params DeleteProjectsNameParams
-}
-
-type DeleteProjectsNameResponseObject interface {
-	VisitDeleteProjectsNameResponse(w http.ResponseWriter) error
-}
-
-type DeleteProjectsName204Response struct {
+	Params GetServicesNameParams
 }
 
-func (response DeleteProjectsName204Response) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetServicesNameResponseObject interface {
+	VisitGetServicesNameResponse(w http.ResponseWriter) error
 }
 
-type DeleteProjectsName400JSONResponse Error
+type GetServicesName200JSONResponse ServiceDetail
 
-func (response DeleteProjectsName400JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+func (response GetServicesName200JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectsName401JSONResponse Error
+type GetServicesName401JSONResponse Error
 
-func (response DeleteProjectsName401JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+func (response GetServicesName401JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectsName403JSONResponse Error
+type GetServicesName403JSONResponse Error
 
-func (response DeleteProjectsName403JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+func (response GetServicesName403JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectsName404JSONResponse Error
+type GetServicesName404JSONResponse Error
 
-func (response DeleteProjectsName404JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+func (response GetServicesName404JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectsName500JSONResponse Error
+type GetServicesName500JSONResponse Error
 
-func (response DeleteProjectsName500JSONResponse) VisitDeleteProjectsNameResponse(w http.ResponseWriter) error {
+func (response GetServicesName500JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectsNameSecretsRequestObject struct {
+type GetServicesNameLogsRequestObject struct {
 	Name   string `json:"name"`
-	Params GetProjectsNameSecretsParams
+	Params GetServicesNameLogsParams
 }
 
-type GetProjectsNameSecretsResponseObject interface {
-	VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error
+type GetServicesNameLogsResponseObject interface {
+	VisitGetServicesNameLogsResponse(w http.ResponseWriter) error
 }
 
-type GetProjectsNameSecrets200JSONResponse struct {
-	union json.RawMessage
-}
+type GetServicesNameLogs200TextResponse string
 
-func (response GetProjectsNameSecrets200JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetServicesNameLogs200TextResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response.union)
+	_, err := w.Write([]byte(response))
+	return err
 }
 
-type GetProjectsNameSecrets401JSONResponse Error
+type GetServicesNameLogs401JSONResponse Error
 
-func (response GetProjectsNameSecrets401JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+func (response GetServicesNameLogs401JSONResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectsNameSecrets403JSONResponse Error
+type GetServicesNameLogs403JSONResponse Error
 
-func (response GetProjectsNameSecrets403JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+func (response GetServicesNameLogs403JSONResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectsNameSecrets404JSONResponse Error
+type GetServicesNameLogs404JSONResponse Error
 
-func (response GetProjectsNameSecrets404JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+func (response GetServicesNameLogs404JSONResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectsNameSecrets500JSONResponse Error
+type GetServicesNameLogs500JSONResponse Error
 
-func (response GetProjectsNameSecrets500JSONResponse) VisitGetProjectsNameSecretsResponse(w http.ResponseWriter) error {
+func (response GetServicesNameLogs500JSONResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutProjectsNameSecretsRequestObject struct {
-	Name   string `json:"name"`
-	Params PutProjectsNameSecretsParams
-	Body   *PutProjectsNameSecretsJSONRequestBody
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Delete a branch
+	// (DELETE /branch)
+	DeleteBranch(ctx context.Context, request DeleteBranchRequestObject) (DeleteBranchResponseObject, error)
+	// Deploy a project
+	// (POST /deploy)
+	PostDeploy(ctx context.Context, request PostDeployRequestObject) (PostDeployResponseObject, error)
+	// Health check
+	// (GET /health)
+	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Get OpenAPI specification.
+	// (GET /openapi.yaml)
+	GetOpenapiYaml(ctx context.Context, request GetOpenapiYamlRequestObject) (GetOpenapiYamlResponseObject, error)
+	// List all projects
+	// (GET /projects)
+	GetProjects(ctx context.Context, request GetProjectsRequestObject) (GetProjectsResponseObject, error)
+	// Create a new project
+	// (POST /projects)
+	PostProjects(ctx context.Context, request PostProjectsRequestObject) (PostProjectsResponseObject, error)
+	// Delete a project
+	// (DELETE /projects/{name})
+	DeleteProjectsName(ctx context.Context, request DeleteProjectsNameRequestObject) (DeleteProjectsNameResponseObject, error)
+	// List uploaded certificates
+	// (GET /projects/{name}/certificates)
+	GetProjectsNameCertificates(ctx context.Context, request GetProjectsNameCertificatesRequestObject) (GetProjectsNameCertificatesResponseObject, error)
+	// Upload a certificate
+	// (POST /projects/{name}/certificates)
+	PostProjectsNameCertificates(ctx context.Context, request PostProjectsNameCertificatesRequestObject) (PostProjectsNameCertificatesResponseObject, error)
+	// Configure preview protection
+	// (PUT /projects/{name}/protection)
+	PutProjectsNameProtection(ctx context.Context, request PutProjectsNameProtectionRequestObject) (PutProjectsNameProtectionResponseObject, error)
+	// Get project secrets
+	// (GET /projects/{name}/secrets)
+	GetProjectsNameSecrets(ctx context.Context, request GetProjectsNameSecretsRequestObject) (GetProjectsNameSecretsResponseObject, error)
+	// Change project secrets
+	// (PATCH /projects/{name}/secrets)
+	PatchProjectsNameSecrets(ctx context.Context, request PatchProjectsNameSecretsRequestObject) (PatchProjectsNameSecretsResponseObject, error)
+	// Update project secrets
+	// (PUT /projects/{name}/secrets)
+	PutProjectsNameSecrets(ctx context.Context, request PutProjectsNameSecretsRequestObject) (PutProjectsNameSecretsResponseObject, error)
+	// Get secret history
+	// (GET /projects/{name}/secrets/versions)
+	GetProjectsNameSecretsVersions(ctx context.Context, request GetProjectsNameSecretsVersionsRequestObject) (GetProjectsNameSecretsVersionsResponseObject, error)
+	// Roll back secrets
+	// (POST /projects/{name}/secrets/versions/{version}/rollback)
+	PostProjectsNameSecretsVersionsVersionRollback(ctx context.Context, request PostProjectsNameSecretsVersionsVersionRollbackRequestObject) (PostProjectsNameSecretsVersionsVersionRollbackResponseObject, error)
+	// List snapshots
+	// (GET /projects/{name}/snapshots)
+	GetProjectsNameSnapshots(ctx context.Context, request GetProjectsNameSnapshotsRequestObject) (GetProjectsNameSnapshotsResponseObject, error)
+	// Restore a snapshot
+	// (POST /projects/{name}/snapshots/{snapshot}/restore)
+	PostProjectsNameSnapshotsSnapshotRestore(ctx context.Context, request PostProjectsNameSnapshotsSnapshotRestoreRequestObject) (PostProjectsNameSnapshotsSnapshotRestoreResponseObject, error)
+	// List volumes
+	// (GET /projects/{name}/volumes)
+	GetProjectsNameVolumes(ctx context.Context, request GetProjectsNameVolumesRequestObject) (GetProjectsNameVolumesResponseObject, error)
+	// Delete a volume
+	// (DELETE /projects/{name}/volumes/{volume})
+	DeleteProjectsNameVolumesVolume(ctx context.Context, request DeleteProjectsNameVolumesVolumeRequestObject) (DeleteProjectsNameVolumesVolumeResponseObject, error)
+	// Download volume contents
+	// (GET /projects/{name}/volumes/{volume}/archive)
+	GetProjectsNameVolumesVolumeArchive(ctx context.Context, request GetProjectsNameVolumesVolumeArchiveRequestObject) (GetProjectsNameVolumesVolumeArchiveResponseObject, error)
+	// Upload volume contents
+	// (PUT /projects/{name}/volumes/{volume}/archive)
+	PutProjectsNameVolumesVolumeArchive(ctx context.Context, request PutProjectsNameVolumesVolumeArchiveRequestObject) (PutProjectsNameVolumesVolumeArchiveResponseObject, error)
+	// Snapshot a volume
+	// (POST /projects/{name}/volumes/{volume}/snapshots)
+	PostProjectsNameVolumesVolumeSnapshots(ctx context.Context, request PostProjectsNameVolumesVolumeSnapshotsRequestObject) (PostProjectsNameVolumesVolumeSnapshotsResponseObject, error)
+	// List all services
+	// (GET /services)
+	GetServices(ctx context.Context, request GetServicesRequestObject) (GetServicesResponseObject, error)
+	// Get service details
+	// (GET /services/{name})
+	GetServicesName(ctx context.Context, request GetServicesNameRequestObject) (GetServicesNameResponseObject, error)
+	// Stream service logs
+	// (GET /services/{name}/logs)
+	GetServicesNameLogs(ctx context.Context, request GetServicesNameLogsRequestObject) (GetServicesNameLogsResponseObject, error)
 }
 
-type PutProjectsNameSecretsResponseObject interface {
-	VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type PutProjectsNameSecrets200Response struct {
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-func (response PutProjectsNameSecrets200Response) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type PutProjectsNameSecrets400JSONResponse Error
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
 
-func (response PutProjectsNameSecrets400JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeleteBranch operation middleware
+func (sh *strictHandler) DeleteBranch(w http.ResponseWriter, r *http.Request, params DeleteBranchParams) {
+	var request DeleteBranchRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PutProjectsNameSecrets401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBranch(ctx, request.(DeleteBranchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBranch")
+	}
 
-func (response PutProjectsNameSecrets401JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteBranchResponseObject); ok {
+		if err := validResponse.VisitDeleteBranchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutProjectsNameSecrets403JSONResponse Error
+// PostDeploy operation middleware
+func (sh *strictHandler) PostDeploy(w http.ResponseWriter, r *http.Request, params PostDeployParams) {
+	var request PostDeployRequestObject
+
+	request.Params = params
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDeploy(ctx, request.(PostDeployRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDeploy")
+	}
 
-func (response PutProjectsNameSecrets403JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDeployResponseObject); ok {
+		if err := validResponse.VisitPostDeployResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutProjectsNameSecrets404JSONResponse Error
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
 
-func (response PutProjectsNameSecrets404JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutProjectsNameSecrets500JSONResponse Error
+// GetOpenapiYaml operation middleware
+func (sh *strictHandler) GetOpenapiYaml(w http.ResponseWriter, r *http.Request) {
+	var request GetOpenapiYamlRequestObject
 
-func (response PutProjectsNameSecrets500JSONResponse) VisitPutProjectsNameSecretsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOpenapiYaml(ctx, request.(GetOpenapiYamlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOpenapiYaml")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetServicesRequestObject struct {
-	Params GetServicesParams
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOpenapiYamlResponseObject); ok {
+		if err := validResponse.VisitGetOpenapiYamlResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServicesResponseObject interface {
-	VisitGetServicesResponse(w http.ResponseWriter) error
-}
+// GetProjects operation middleware
+func (sh *strictHandler) GetProjects(w http.ResponseWriter, r *http.Request, params GetProjectsParams) {
+	var request GetProjectsRequestObject
 
-type GetServices200JSONResponse struct {
-	Services *[]ServiceListItem `json:"services,omitempty"`
-}
+	request.Params = params
 
-func (response GetServices200JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjects(ctx, request.(GetProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjects")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectsResponseObject); ok {
+		if err := validResponse.VisitGetProjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServices401JSONResponse Error
+// PostProjects operation middleware
+func (sh *strictHandler) PostProjects(w http.ResponseWriter, r *http.Request, params PostProjectsParams) {
+	var request PostProjectsRequestObject
 
-func (response GetServices401JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostProjectsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetServices500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProjects(ctx, request.(PostProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProjects")
+	}
 
-func (response GetServices500JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProjectsResponseObject); ok {
+		if err := validResponse.VisitPostProjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServicesNameRequestObject struct {
-	Name   string `json:"name"`
-	Params GetServicesNameParams
-}
+// DeleteProjectsName operation middleware
+func (sh *strictHandler) DeleteProjectsName(w http.ResponseWriter, r *http.Request, name string, params DeleteProjectsNameParams) {
+	var request DeleteProjectsNameRequestObject
 
-type GetServicesNameResponseObject interface {
-	VisitGetServicesNameResponse(w http.ResponseWriter) error
-}
+	request.Name = name
+	request.Params = params
 
-type GetServicesName200JSONResponse ServiceDetail
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProjectsName(ctx, request.(DeleteProjectsNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProjectsName")
+	}
 
-func (response GetServicesName200JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProjectsNameResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectsNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServicesName401JSONResponse Error
-
-func (response GetServicesName401JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetProjectsNameCertificates operation middleware
+func (sh *strictHandler) GetProjectsNameCertificates(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameCertificatesParams) {
+	var request GetProjectsNameCertificatesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Name = name
+	request.Params = params
 
-type GetServicesName403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectsNameCertificates(ctx, request.(GetProjectsNameCertificatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectsNameCertificates")
+	}
 
-func (response GetServicesName403JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectsNameCertificatesResponseObject); ok {
+		if err := validResponse.VisitGetProjectsNameCertificatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServicesName404JSONResponse Error
+// PostProjectsNameCertificates operation middleware
+func (sh *strictHandler) PostProjectsNameCertificates(w http.ResponseWriter, r *http.Request, name string, params PostProjectsNameCertificatesParams) {
+	var request PostProjectsNameCertificatesRequestObject
 
-func (response GetServicesName404JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostProjectsNameCertificatesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetServicesName500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProjectsNameCertificates(ctx, request.(PostProjectsNameCertificatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProjectsNameCertificates")
+	}
 
-func (response GetServicesName500JSONResponse) VisitGetServicesNameResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProjectsNameCertificatesResponseObject); ok {
+		if err := validResponse.VisitPostProjectsNameCertificatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServicesNameLogsRequestObject struct {
-	Name   string `json:"name"`
-	Params GetServicesNameLogsParams
-}
+// PutProjectsNameProtection operation middleware
+func (sh *strictHandler) PutProjectsNameProtection(w http.ResponseWriter, r *http.Request, name string, params PutProjectsNameProtectionParams) {
+	var request PutProjectsNameProtectionRequestObject
 
-type GetServicesNameLogsResponseObject interface {
-	VisitGetServicesNameLogsResponse(w http.ResponseWriter) error
-}
+	request.Name = name
+	request.Params = params
 
-type GetServicesNameLogs200TextResponse string
+	var body PutProjectsNameProtectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetServicesNameLogs200TextResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/plain")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProjectsNameProtection(ctx, request.(PutProjectsNameProtectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProjectsNameProtection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	_, err := w.Write([]byte(response))
-	return err
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutProjectsNameProtectionResponseObject); ok {
+		if err := validResponse.VisitPutProjectsNameProtectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServicesNameLogs401JSONResponse Error
-
-func (response GetServicesNameLogs401JSONResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetProjectsNameSecrets operation middleware
+func (sh *strictHandler) GetProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameSecretsParams) {
+	var request GetProjectsNameSecretsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Name = name
+	request.Params = params
 
-type GetServicesNameLogs403JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProjectsNameSecrets(ctx, request.(GetProjectsNameSecretsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProjectsNameSecrets")
+	}
 
-func (response GetServicesNameLogs403JSONResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectsNameSecretsResponseObject); ok {
+		if err := validResponse.VisitGetProjectsNameSecretsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetServicesNameLogs404JSONResponse Error
+// PatchProjectsNameSecrets operation middleware
+func (sh *strictHandler) PatchProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params PatchProjectsNameSecretsParams) {
+	var request PatchProjectsNameSecretsRequestObject
 
-func (response GetServicesNameLogs404JSONResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Name = name
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PatchProjectsNameSecretsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetServicesNameLogs500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProjectsNameSecrets(ctx, request.(PatchProjectsNameSecretsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProjectsNameSecrets")
+	}
 
-func (response GetServicesNameLogs500JSONResponse) VisitGetServicesNameLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchProjectsNameSecretsResponseObject); ok {
+		if err := validResponse.VisitPatchProjectsNameSecretsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Delete a branch
-	// (DELETE /branch)
-	DeleteBranch(ctx context.Context, request DeleteBranchRequestObject) (DeleteBranchResponseObject, error)
-	// Deploy a project
-	// (POST /deploy)
-	PostDeploy(ctx context.Context, request PostDeployRequestObject) (PostDeployResponseObject, error)
-	// Health check
-	// (GET /health)
-	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
-	// Get OpenAPI specification.
-	// (GET /openapi.yaml)
-	GetOpenapiYaml(ctx context.Context, request GetOpenapiYamlRequestObject) (GetOpenapiYamlResponseObject, error)
-	// List all projects
-	// (GET /projects)
-	GetProjects(ctx context.Context, request GetProjectsRequestObject) (GetProjectsResponseObject, error)
-	// Create a new project
-	// (POST /projects)
-	PostProjects(ctx context.Context, request PostProjectsRequestObject) (PostProjectsResponseObject, error)
-	// Delete a project
-	// (DELETE /projects/{name})
-	DeleteProjectsName(ctx context.Context, request DeleteProjectsNameRequestObject) (DeleteProjectsNameResponseObject, error)
-	// Get project secrets
-	// (GET /projects/{name}/secrets)
-	GetProjectsNameSecrets(ctx context.Context, request GetProjectsNameSecretsRequestObject) (GetProjectsNameSecretsResponseObject, error)
-	// Update project secrets
-	// (PUT /projects/{name}/secrets)
-	PutProjectsNameSecrets(ctx context.Context, request PutProjectsNameSecretsRequestObject) (PutProjectsNameSecretsResponseObject, error)
-	// List all services
-	// (GET /services)
-	GetServices(ctx context.Context, request GetServicesRequestObject) (GetServicesResponseObject, error)
-	// Get service details
-	// (GET /services/{name})
-	GetServicesName(ctx context.Context, request GetServicesNameRequestObject) (GetServicesNameResponseObject, error)
-	// Stream service logs
-	// (GET /services/{name}/logs)
-	GetServicesNameLogs(ctx context.Context, request GetServicesNameLogsRequestObject) (GetServicesNameLogsResponseObject, error)
-}
+// PutProjectsNameSecrets operation middleware
+func (sh *strictHandler) PutProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params PutProjectsNameSecretsParams) {
+	var request PutProjectsNameSecretsRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.Name = name
+	request.Params = params
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body PutProjectsNameSecretsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProjectsNameSecrets(ctx, request.(PutProjectsNameSecretsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProjectsNameSecrets")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutProjectsNameSecretsResponseObject); ok {
+		if err := validResponse.VisitPutProjectsNameSecretsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// DeleteBranch operation middleware
-func (sh *strictHandler) DeleteBranch(w http.ResponseWriter, r *http.Request, params DeleteBranchParams) {
-	var request DeleteBranchRequestObject
+// GetProjectsNameSecretsVersions operation middleware
+func (sh *strictHandler) GetProjectsNameSecretsVersions(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameSecretsVersionsParams) {
+	var request GetProjectsNameSecretsVersionsRequestObject
 
+	request.Name = name
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteBranch(ctx, request.(DeleteBranchRequestObject))
+		return sh.ssi.GetProjectsNameSecretsVersions(ctx, request.(GetProjectsNameSecretsVersionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteBranch")
+		handler = middleware(handler, "GetProjectsNameSecretsVersions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteBranchResponseObject); ok {
-		if err := validResponse.VisitDeleteBranchResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProjectsNameSecretsVersionsResponseObject); ok {
+		if err := validResponse.VisitGetProjectsNameSecretsVersionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3775,32 +8917,27 @@ func (sh *strictHandler) DeleteBranch(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// PostDeploy operation middleware
-func (sh *strictHandler) PostDeploy(w http.ResponseWriter, r *http.Request, params PostDeployParams) {
-	var request PostDeployRequestObject
+// PostProjectsNameSecretsVersionsVersionRollback operation middleware
+func (sh *strictHandler) PostProjectsNameSecretsVersionsVersionRollback(w http.ResponseWriter, r *http.Request, name string, version int32, params PostProjectsNameSecretsVersionsVersionRollbackParams) {
+	var request PostProjectsNameSecretsVersionsVersionRollbackRequestObject
 
+	request.Name = name
+	request.Version = version
 	request.Params = params
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
-		return
-	} else {
-		request.Body = reader
-	}
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostDeploy(ctx, request.(PostDeployRequestObject))
+		return sh.ssi.PostProjectsNameSecretsVersionsVersionRollback(ctx, request.(PostProjectsNameSecretsVersionsVersionRollbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostDeploy")
+		handler = middleware(handler, "PostProjectsNameSecretsVersionsVersionRollback")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostDeployResponseObject); ok {
-		if err := validResponse.VisitPostDeployResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostProjectsNameSecretsVersionsVersionRollbackResponseObject); ok {
+		if err := validResponse.VisitPostProjectsNameSecretsVersionsVersionRollbackResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3808,23 +8945,26 @@ func (sh *strictHandler) PostDeploy(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// GetProjectsNameSnapshots operation middleware
+func (sh *strictHandler) GetProjectsNameSnapshots(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameSnapshotsParams) {
+	var request GetProjectsNameSnapshotsRequestObject
+
+	request.Name = name
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.GetProjectsNameSnapshots(ctx, request.(GetProjectsNameSnapshotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "GetProjectsNameSnapshots")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProjectsNameSnapshotsResponseObject); ok {
+		if err := validResponse.VisitGetProjectsNameSnapshotsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3832,23 +8972,27 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetOpenapiYaml operation middleware
-func (sh *strictHandler) GetOpenapiYaml(w http.ResponseWriter, r *http.Request) {
-	var request GetOpenapiYamlRequestObject
+// PostProjectsNameSnapshotsSnapshotRestore operation middleware
+func (sh *strictHandler) PostProjectsNameSnapshotsSnapshotRestore(w http.ResponseWriter, r *http.Request, name string, snapshot openapi_types.UUID, params PostProjectsNameSnapshotsSnapshotRestoreParams) {
+	var request PostProjectsNameSnapshotsSnapshotRestoreRequestObject
+
+	request.Name = name
+	request.Snapshot = snapshot
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetOpenapiYaml(ctx, request.(GetOpenapiYamlRequestObject))
+		return sh.ssi.PostProjectsNameSnapshotsSnapshotRestore(ctx, request.(PostProjectsNameSnapshotsSnapshotRestoreRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetOpenapiYaml")
+		handler = middleware(handler, "PostProjectsNameSnapshotsSnapshotRestore")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetOpenapiYamlResponseObject); ok {
-		if err := validResponse.VisitGetOpenapiYamlResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostProjectsNameSnapshotsSnapshotRestoreResponseObject); ok {
+		if err := validResponse.VisitPostProjectsNameSnapshotsSnapshotRestoreResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3856,25 +9000,26 @@ func (sh *strictHandler) GetOpenapiYaml(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetProjects operation middleware
-func (sh *strictHandler) GetProjects(w http.ResponseWriter, r *http.Request, params GetProjectsParams) {
-	var request GetProjectsRequestObject
+// GetProjectsNameVolumes operation middleware
+func (sh *strictHandler) GetProjectsNameVolumes(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameVolumesParams) {
+	var request GetProjectsNameVolumesRequestObject
 
+	request.Name = name
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjects(ctx, request.(GetProjectsRequestObject))
+		return sh.ssi.GetProjectsNameVolumes(ctx, request.(GetProjectsNameVolumesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjects")
+		handler = middleware(handler, "GetProjectsNameVolumes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProjectsResponseObject); ok {
-		if err := validResponse.VisitGetProjectsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProjectsNameVolumesResponseObject); ok {
+		if err := validResponse.VisitGetProjectsNameVolumesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3882,32 +9027,27 @@ func (sh *strictHandler) GetProjects(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// PostProjects operation middleware
-func (sh *strictHandler) PostProjects(w http.ResponseWriter, r *http.Request, params PostProjectsParams) {
-	var request PostProjectsRequestObject
+// DeleteProjectsNameVolumesVolume operation middleware
+func (sh *strictHandler) DeleteProjectsNameVolumesVolume(w http.ResponseWriter, r *http.Request, name string, volume string, params DeleteProjectsNameVolumesVolumeParams) {
+	var request DeleteProjectsNameVolumesVolumeRequestObject
 
+	request.Name = name
+	request.Volume = volume
 	request.Params = params
 
-	var body PostProjectsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProjects(ctx, request.(PostProjectsRequestObject))
+		return sh.ssi.DeleteProjectsNameVolumesVolume(ctx, request.(DeleteProjectsNameVolumesVolumeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProjects")
+		handler = middleware(handler, "DeleteProjectsNameVolumesVolume")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostProjectsResponseObject); ok {
-		if err := validResponse.VisitPostProjectsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteProjectsNameVolumesVolumeResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectsNameVolumesVolumeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3915,26 +9055,27 @@ func (sh *strictHandler) PostProjects(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// DeleteProjectsName operation middleware
-func (sh *strictHandler) DeleteProjectsName(w http.ResponseWriter, r *http.Request, name string, params DeleteProjectsNameParams) {
-	var request DeleteProjectsNameRequestObject
+// GetProjectsNameVolumesVolumeArchive operation middleware
+func (sh *strictHandler) GetProjectsNameVolumesVolumeArchive(w http.ResponseWriter, r *http.Request, name string, volume string, params GetProjectsNameVolumesVolumeArchiveParams) {
+	var request GetProjectsNameVolumesVolumeArchiveRequestObject
 
 	request.Name = name
+	request.Volume = volume
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectsName(ctx, request.(DeleteProjectsNameRequestObject))
+		return sh.ssi.GetProjectsNameVolumesVolumeArchive(ctx, request.(GetProjectsNameVolumesVolumeArchiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectsName")
+		handler = middleware(handler, "GetProjectsNameVolumesVolumeArchive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteProjectsNameResponseObject); ok {
-		if err := validResponse.VisitDeleteProjectsNameResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProjectsNameVolumesVolumeArchiveResponseObject); ok {
+		if err := validResponse.VisitGetProjectsNameVolumesVolumeArchiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3942,26 +9083,29 @@ func (sh *strictHandler) DeleteProjectsName(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetProjectsNameSecrets operation middleware
-func (sh *strictHandler) GetProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params GetProjectsNameSecretsParams) {
-	var request GetProjectsNameSecretsRequestObject
+// PutProjectsNameVolumesVolumeArchive operation middleware
+func (sh *strictHandler) PutProjectsNameVolumesVolumeArchive(w http.ResponseWriter, r *http.Request, name string, volume string, params PutProjectsNameVolumesVolumeArchiveParams) {
+	var request PutProjectsNameVolumesVolumeArchiveRequestObject
 
 	request.Name = name
+	request.Volume = volume
 	request.Params = params
 
+	request.Body = r.Body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectsNameSecrets(ctx, request.(GetProjectsNameSecretsRequestObject))
+		return sh.ssi.PutProjectsNameVolumesVolumeArchive(ctx, request.(PutProjectsNameVolumesVolumeArchiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectsNameSecrets")
+		handler = middleware(handler, "PutProjectsNameVolumesVolumeArchive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProjectsNameSecretsResponseObject); ok {
-		if err := validResponse.VisitGetProjectsNameSecretsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutProjectsNameVolumesVolumeArchiveResponseObject); ok {
+		if err := validResponse.VisitPutProjectsNameVolumesVolumeArchiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -3969,33 +9113,27 @@ func (sh *strictHandler) GetProjectsNameSecrets(w http.ResponseWriter, r *http.R
 	}
 }
 
-// PutProjectsNameSecrets operation middleware
-func (sh *strictHandler) PutProjectsNameSecrets(w http.ResponseWriter, r *http.Request, name string, params PutProjectsNameSecretsParams) {
-	var request PutProjectsNameSecretsRequestObject
+// PostProjectsNameVolumesVolumeSnapshots operation middleware
+func (sh *strictHandler) PostProjectsNameVolumesVolumeSnapshots(w http.ResponseWriter, r *http.Request, name string, volume string, params PostProjectsNameVolumesVolumeSnapshotsParams) {
+	var request PostProjectsNameVolumesVolumeSnapshotsRequestObject
 
 	request.Name = name
+	request.Volume = volume
 	request.Params = params
 
-	var body PutProjectsNameSecretsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutProjectsNameSecrets(ctx, request.(PutProjectsNameSecretsRequestObject))
+		return sh.ssi.PostProjectsNameVolumesVolumeSnapshots(ctx, request.(PostProjectsNameVolumesVolumeSnapshotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutProjectsNameSecrets")
+		handler = middleware(handler, "PostProjectsNameVolumesVolumeSnapshots")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutProjectsNameSecretsResponseObject); ok {
-		if err := validResponse.VisitPutProjectsNameSecretsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostProjectsNameVolumesVolumeSnapshotsResponseObject); ok {
+		if err := validResponse.VisitPostProjectsNameVolumesVolumeSnapshotsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
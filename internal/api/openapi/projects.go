@@ -6,13 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"slices"
+	"sort"
+	"strings"
 
 	apierror "nimbus/internal/api/error"
 	"nimbus/internal/api/requestid"
 	"nimbus/internal/database"
 	"nimbus/internal/env"
 	"nimbus/internal/kubernetes"
+	"nimbus/internal/models"
+	"nimbus/internal/secrets"
 	"nimbus/internal/utils"
 
 	"github.com/jackc/pgx/v5"
@@ -204,6 +207,10 @@ func (Server) DeleteProjectsName(
 				ErrorId: requestid,
 			}, nil
 		}
+		err = env.Secrets.Delete(ctx, project.Name, branch)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to delete secrets", slog.Any("error", err))
+		}
 		err = kubernetes.DeleteNamespace(ctx, namespace, env)
 		if err != nil {
 			env.Logger.ErrorContext(ctx, "failed to delete namespace", slog.Any("error", err))
@@ -216,6 +223,13 @@ func (Server) DeleteProjectsName(
 		}
 	}
 
+	for _, branch := range []string{"", secrets.PreviewAuthBranch} {
+		err = env.Secrets.Delete(ctx, project.Name, branch)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to delete secrets", slog.Any("error", err))
+		}
+	}
+
 	err = env.Database.DeleteProject(ctx, project.ID)
 	if err != nil {
 		env.Logger.ErrorContext(ctx, "failed to delete project", slog.Any("error", err))
@@ -285,24 +299,49 @@ func (Server) GetProjectsNameSecrets(
 		}, nil
 	}
 
-	// Get secrets
-	var res []byte
+	// Get secrets, the defaults or the resolved secrets of a branch
 	env.Logger.DebugContext(ctx, "getting secrets")
+	var values map[string]string
+	var sources *SecretSources
+	if request.Params.Branch != nil {
+		var branchSources map[string]string
+		values, branchSources, err = secrets.Resolve(ctx, env.Secrets, project.Name, *request.Params.Branch)
+		sources = (*SecretSources)(&branchSources)
+	} else {
+		values, err = env.Secrets.Get(ctx, project.Name, "")
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get secrets", slog.Any("error", err))
+		return GetProjectsNameSecrets500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get the resource version of the defaults or the branch overrides
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	}
+	version, err := env.Database.GetLatestSecretVersion(ctx, database.GetLatestSecretVersionParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get secret version", slog.Any("error", err))
+		return GetProjectsNameSecrets500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	var res []byte
 	if request.Params.Values != nil && *request.Params.Values {
-		vals, err := kubernetes.GetSecretValues(ctx, project.Name, env)
-		if err != nil {
-			env.Logger.ErrorContext(ctx, "failed to get secret values", slog.Any("error", err))
-			return GetProjectsNameSecrets500JSONResponse{
-				Status:  apierror.InternalServerError.Status(),
-				Code:    apierror.InternalServerError.String(),
-				Message: "Internal Server Error",
-				ErrorId: requestid,
-			}, nil
-		}
-		if vals == nil {
-			vals = make(map[string]string)
-		}
-		res, err = json.Marshal(SecretsValuesResponse{Secrets: &vals})
+		res, err = json.Marshal(SecretsValuesResponse{Secrets: &values, Sources: sources, Version: version})
 		if err != nil {
 			env.Logger.ErrorContext(ctx, "failed to marshal secret values", slog.Any("error", err))
 			return GetProjectsNameSecrets500JSONResponse{
@@ -313,20 +352,12 @@ func (Server) GetProjectsNameSecrets(
 			}, nil
 		}
 	} else {
-		names, err := kubernetes.ListSecretNames(ctx, project.Name, env)
-		if err != nil {
-			env.Logger.ErrorContext(ctx, "failed to get secret names", slog.Any("error", err))
-			return GetProjectsNameSecrets500JSONResponse{
-				Status:  apierror.InternalServerError.Status(),
-				Code:    apierror.InternalServerError.String(),
-				Message: "Internal Server Error",
-				ErrorId: requestid,
-			}, nil
-		}
-		if names == nil {
-			names = make([]string, 0)
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
 		}
-		res, err = json.Marshal(SecretsNamesResponse{Secrets: &names})
+		sort.Strings(names)
+		res, err = json.Marshal(SecretsNamesResponse{Secrets: &names, Sources: sources, Version: version})
 		if err != nil {
 			env.Logger.ErrorContext(ctx, "failed to marshal secret names", slog.Any("error", err))
 			return GetProjectsNameSecrets500JSONResponse{
@@ -396,11 +427,32 @@ func (Server) PutProjectsNameSecrets(
 		}, nil
 	}
 
-	// Get project branches
-	env.Logger.DebugContext(ctx, "getting project branches")
-	branches, err := env.Database.GetProjectBranches(ctx, project.ID)
+	var values map[string]string
+	if request.Body.Secrets != nil {
+		values = *request.Body.Secrets
+	} else {
+		values = make(map[string]string)
+	}
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	}
+
+	restart := request.Params.Restart == nil || *request.Params.Restart
+
+	// Replace secrets
+	write, err := writeSecrets(ctx, project, branch, values, request.Body.Version, user, restart, env)
+	if errors.Is(err, errSecretsConflict) {
+		return PutProjectsNameSecrets409JSONResponse{
+			Status:  apierror.SecretsConflict.Status(),
+			Code:    apierror.SecretsConflict.String(),
+			Message: "secrets were changed concurrently, get them again and retry",
+			ErrorId: requestid,
+		}, nil
+	}
 	if err != nil {
-		env.Logger.ErrorContext(ctx, "failed to get project branches", slog.Any("error", err))
+		env.Logger.ErrorContext(ctx, "failed to update secrets", slog.Any("error", err))
 		return PutProjectsNameSecrets500JSONResponse{
 			Status:  apierror.InternalServerError.Status(),
 			Code:    apierror.InternalServerError.String(),
@@ -408,28 +460,200 @@ func (Server) PutProjectsNameSecrets(
 			ErrorId: requestid,
 		}, nil
 	}
-	if len(branches) == 0 {
-		branches = []string{"main"}
+
+	return PutProjectsNameSecrets200JSONResponse{Version: write.Latest, Restarted: write.Restarted}, nil
+}
+
+func (Server) PutProjectsNameProtection(
+	ctx context.Context, request PutProjectsNameProtectionRequestObject,
+) (PutProjectsNameProtectionResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	if request.Body == nil {
+		return PutProjectsNameProtection400JSONResponse{
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			Message: "request body is required",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PutProjectsNameProtection404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
 	}
-	if !slices.Contains(branches, "main") && !slices.Contains(branches, "master") {
-		branches = append(branches, "main")
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PutProjectsNameProtection500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
 	}
 
-	var secrets map[string]string
-	if request.Body.Secrets != nil {
-		secrets = *request.Body.Secrets
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return PutProjectsNameProtection500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return PutProjectsNameProtection403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to protect project",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Store protection
+	var protection *models.PreviewProtection
+	if request.Body.Mode == None {
+		env.Logger.DebugContext(ctx, "removing preview protection")
+		err = env.Database.DeletePreviewProtection(ctx, project.ID)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to delete preview protection", slog.Any("error", err))
+			return PutProjectsNameProtection500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestid,
+			}, nil
+		}
 	} else {
-		secrets = make(map[string]string)
+		protection = &models.PreviewProtection{
+			Mode:      string(request.Body.Mode),
+			Allowlist: []string{},
+		}
+		if request.Body.Allowlist != nil {
+			protection.Allowlist = *request.Body.Allowlist
+		}
+		if err := protection.Validate(); err != nil {
+			return PutProjectsNameProtection422JSONResponse{
+				Status:  apierror.UnprocessibleContent.Status(),
+				Code:    apierror.UnprocessibleContent.String(),
+				Message: fmt.Sprintf("invalid preview protection: %s", err),
+				ErrorId: requestid,
+			}, nil
+		}
+
+		if request.Body.Mode == BasicAuth {
+			if request.Body.Username == nil || *request.Body.Username == "" ||
+				strings.Contains(*request.Body.Username, ":") ||
+				request.Body.Password == nil || *request.Body.Password == "" {
+				return PutProjectsNameProtection422JSONResponse{
+					Status:  apierror.UnprocessibleContent.Status(),
+					Code:    apierror.UnprocessibleContent.String(),
+					Message: "basic-auth requires a username without ':' and a password",
+					ErrorId: requestid,
+				}, nil
+			}
+			env.Logger.DebugContext(ctx, "storing preview credentials")
+			entry, err := kubernetes.PreviewAuthEntry(*request.Body.Username, *request.Body.Password)
+			if err == nil {
+				err = env.Secrets.Put(ctx, project.Name, secrets.PreviewAuthBranch, map[string]string{"auth": entry})
+			}
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to store preview credentials", slog.Any("error", err))
+				return PutProjectsNameProtection500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestid,
+				}, nil
+			}
+		}
+
+		env.Logger.DebugContext(ctx, "storing preview protection", slog.String("mode", protection.Mode))
+		err = env.Database.UpsertPreviewProtection(ctx, database.UpsertPreviewProtectionParams{
+			ProjectID: project.ID,
+			Mode:      protection.Mode,
+			Allowlist: protection.Allowlist,
+		})
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to store preview protection", slog.Any("error", err))
+			return PutProjectsNameProtection500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestid,
+			}, nil
+		}
+	}
+
+	// Credentials are only kept while basic auth is enabled
+	if protection == nil || protection.Mode != "basic-auth" {
+		env.Logger.DebugContext(ctx, "removing preview credentials")
+		err = env.Secrets.Delete(ctx, project.Name, secrets.PreviewAuthBranch)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to remove preview credentials", slog.Any("error", err))
+			return PutProjectsNameProtection500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestid,
+			}, nil
+		}
+	}
+
+	// Apply to existing previews
+	env.Logger.DebugContext(ctx, "getting project branches")
+	branches, err := env.Database.GetProjectBranches(ctx, project.ID)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project branches", slog.Any("error", err))
+		return PutProjectsNameProtection500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
 	}
 	for _, branch := range branches {
+		if branch == "main" || branch == "master" {
+			continue
+		}
 		namespace := utils.GetSanitizedNamespace(project.Name, branch)
-		env.Logger.DebugContext(ctx, "Updating secrets for namespace", slog.String("namespace", namespace))
-		err = kubernetes.UpdateSecret(
-			ctx, namespace, fmt.Sprintf("%s-env", project.Name), secrets, env,
-		)
+		env.Logger.DebugContext(ctx, "protecting preview", slog.String("namespace", namespace))
+		err = syncPreviewAuth(ctx, project.Name, namespace, protection, env)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to sync preview auth secret",
+				slog.String("namespace", namespace),
+				slog.Any("error", err))
+			return PutProjectsNameProtection500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestid,
+			}, nil
+		}
+		err = kubernetes.ProtectIngresses(ctx, namespace, protection, env)
 		if err != nil {
-			env.Logger.ErrorContext(ctx, "failed to update secrets", slog.Any("error", err))
-			return PutProjectsNameSecrets500JSONResponse{
+			env.Logger.ErrorContext(ctx, "failed to protect preview ingresses",
+				slog.String("namespace", namespace),
+				slog.Any("error", err))
+			return PutProjectsNameProtection500JSONResponse{
 				Status:  apierror.InternalServerError.Status(),
 				Code:    apierror.InternalServerError.String(),
 				Message: "Internal Server Error",
@@ -438,5 +662,24 @@ func (Server) PutProjectsNameSecrets(
 		}
 	}
 
-	return PutProjectsNameSecrets200Response{}, nil
+	return PutProjectsNameProtection200Response{}, nil
+}
+
+// syncPreviewAuth writes the stored basic auth credentials of a project to
+// the auth secret of a preview namespace, or removes the auth secret when the
+// previews are not protected with basic auth.
+func syncPreviewAuth(
+	ctx context.Context, project, namespace string, protection *models.PreviewProtection, env *env.Env,
+) error {
+	if protection == nil || protection.Mode != "basic-auth" {
+		return kubernetes.DeletePreviewAuthSecret(ctx, namespace, env)
+	}
+	values, err := env.Secrets.Get(ctx, project, secrets.PreviewAuthBranch)
+	if err != nil {
+		return fmt.Errorf("getting preview credentials: %w", err)
+	}
+	if values["auth"] == "" {
+		return fmt.Errorf("no preview credentials stored")
+	}
+	return kubernetes.UpdatePreviewAuthSecret(ctx, namespace, values["auth"], env)
 }
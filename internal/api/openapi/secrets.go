@@ -0,0 +1,629 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	apierror "nimbus/internal/api/error"
+	"nimbus/internal/api/requestid"
+	"nimbus/internal/database"
+	"nimbus/internal/env"
+	"nimbus/internal/kubernetes"
+	"nimbus/internal/secrets"
+	"nimbus/internal/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// uniqueViolation is the postgres error code of a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+// errSecretsConflict is returned by writeSecrets when the secrets changed
+// since the expected version.
+var errSecretsConflict = errors.New("secrets changed concurrently")
+
+// secretsWrite is the outcome of writeSecrets.
+type secretsWrite struct {
+	// Version is the recorded version, set when Created
+	Version database.SecretVersion
+	// Created reports whether the secrets changed and a version was recorded
+	Created bool
+	// Latest is the resource version of the secrets after the write
+	Latest int32
+	// Restarted lists the services restarted to read the changed secrets
+	Restarted []RestartedServices
+}
+
+// writeSecrets stores the secret defaults of a project, or the overrides of
+// a branch when branch is set, and syncs the resolved secrets to the affected
+// branches. Every change is recorded as a new version. When expected is set,
+// the write fails with errSecretsConflict unless it is the latest version.
+// With restart set, the services reading a changed secret are restarted in
+// each affected branch.
+func writeSecrets(
+	ctx context.Context, project database.Project, branch string, values map[string]string,
+	expected *int32, user *database.User, restart bool, env *env.Env,
+) (secretsWrite, error) {
+	cipher, err := secrets.NewCipher(env.Config.SecretsKey)
+	if err != nil {
+		return secretsWrite{}, fmt.Errorf("creating cipher: %w", err)
+	}
+
+	latest, err := env.Database.GetLatestSecretVersion(ctx, database.GetLatestSecretVersionParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if err != nil {
+		return secretsWrite{}, fmt.Errorf("getting latest secret version: %w", err)
+	}
+	if expected != nil && *expected != latest {
+		return secretsWrite{}, errSecretsConflict
+	}
+
+	previous, err := env.Secrets.Get(ctx, project.Name, branch)
+	if err != nil {
+		return secretsWrite{}, fmt.Errorf("getting previous secrets: %w", err)
+	}
+
+	write := secretsWrite{
+		Created:   !secrets.Diff(previous, values).Empty(),
+		Latest:    latest,
+		Restarted: []RestartedServices{},
+	}
+	if !write.Created {
+		return write, nil
+	}
+
+	// The version is recorded before the secrets are stored, concurrent
+	// writes expecting the same version conflict on it and leave the store
+	// untouched.
+	write.Version, err = createSecretVersion(ctx, project, branch, latest+1, previous, values, user, cipher, env)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return secretsWrite{}, errSecretsConflict
+	}
+	if err != nil {
+		return secretsWrite{}, err
+	}
+	write.Latest = write.Version.Version
+
+	env.Logger.DebugContext(ctx, "storing secrets", slog.String("branch", branch))
+	err = env.Secrets.Put(ctx, project.Name, branch, values)
+	if err != nil {
+		// Drop the version again, the secrets it records were never stored
+		deleteErr := env.Database.DeleteSecretVersion(ctx, database.DeleteSecretVersionParams{
+			ProjectID:     project.ID,
+			ProjectBranch: branch,
+			Version:       write.Version.Version,
+		})
+		if deleteErr != nil {
+			env.Logger.ErrorContext(ctx, "failed to delete secret version",
+				slog.String("branch", branch),
+				slog.Int("version", int(write.Version.Version)),
+				slog.Any("error", deleteErr))
+		}
+		return secretsWrite{}, fmt.Errorf("storing secrets: %w", err)
+	}
+
+	// Overrides only apply to their branch, every branch inherits the defaults
+	branches := []string{branch}
+	if branch == "" {
+		branches, err = env.Database.GetProjectBranches(ctx, project.ID)
+		if err != nil {
+			return secretsWrite{}, fmt.Errorf("getting project branches: %w", err)
+		}
+		if !slices.Contains(branches, "main") && !slices.Contains(branches, "master") {
+			branches = append(branches, "main")
+		}
+	}
+	for _, branch := range branches {
+		env.Logger.DebugContext(ctx, "syncing secrets", slog.String("branch", branch))
+		changed, err := syncSecrets(ctx, project.Name, branch, env)
+		if err != nil {
+			return secretsWrite{}, fmt.Errorf("syncing secrets of branch %s: %w", branch, err)
+		}
+		if !restart || len(changed) == 0 {
+			continue
+		}
+
+		services := restartSecretReaders(ctx, project.Name, branch, changed, env)
+		if len(services) > 0 {
+			write.Restarted = append(write.Restarted, RestartedServices{Branch: branch, Services: services})
+		}
+	}
+	return write, nil
+}
+
+// syncSecrets writes the resolved secrets of a branch to kubernetes, where
+// its services read them from, and returns the keys whose value changed.
+func syncSecrets(ctx context.Context, project, branch string, env *env.Env) ([]string, error) {
+	values, _, err := secrets.Resolve(ctx, env.Secrets, project, branch)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.SyncSecrets(ctx, project, branch, values, env)
+}
+
+// restartSecretReaders restarts the services of a branch reading any of the
+// changed secrets and returns their names. Services failing to restart are
+// logged and left out, the secrets are stored already.
+func restartSecretReaders(ctx context.Context, project, branch string, changed []string, env *env.Env) []string {
+	namespace := utils.GetSanitizedNamespace(project, branch)
+	readers, err := kubernetes.GetDeploymentsReadingSecret(
+		ctx, namespace, kubernetes.EnvSecretName(project), changed, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get services reading secrets",
+			slog.String("namespace", namespace),
+			slog.Any("error", err))
+		return nil
+	}
+
+	restarted := make([]string, 0, len(readers))
+	for _, service := range readers {
+		env.Logger.DebugContext(ctx, "restarting service",
+			slog.String("namespace", namespace),
+			slog.String("service", service))
+		err = kubernetes.RestartDeployment(ctx, namespace, service, env)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to restart service",
+				slog.String("namespace", namespace),
+				slog.String("service", service),
+				slog.Any("error", err))
+			continue
+		}
+		restarted = append(restarted, service)
+	}
+	return restarted
+}
+
+// createSecretVersion records the encrypted values of a secret change and the
+// keys it added, removed and changed as the given version. Recording a
+// version which exists already fails with a unique violation.
+func createSecretVersion(
+	ctx context.Context, project database.Project, branch string, version int32, previous, values map[string]string,
+	user *database.User, cipher *secrets.Cipher, env *env.Env,
+) (database.SecretVersion, error) {
+	data, err := cipher.EncryptValues(values)
+	if err != nil {
+		return database.SecretVersion{}, fmt.Errorf("encrypting secrets: %w", err)
+	}
+	var userID pgtype.UUID
+	if user != nil {
+		userID = pgtype.UUID{Bytes: user.ID, Valid: true}
+	}
+
+	changes := secrets.Diff(previous, values)
+	created, err := env.Database.CreateSecretVersion(ctx, database.CreateSecretVersionParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+		Version:       version,
+		Data:          data,
+		UserID:        userID,
+		Added:         changes.Added,
+		Removed:       changes.Removed,
+		Changed:       changes.Changed,
+	})
+	if err != nil {
+		return database.SecretVersion{}, fmt.Errorf("creating secret version: %w", err)
+	}
+	return created, nil
+}
+
+func (Server) GetProjectsNameSecretsVersions(
+	ctx context.Context, request GetProjectsNameSecretsVersionsRequestObject,
+) (GetProjectsNameSecretsVersionsResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameSecretsVersions404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameSecretsVersions500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get user permissions", slog.Any("error", err))
+		return GetProjectsNameSecretsVersions500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return GetProjectsNameSecretsVersions403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to view secrets",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get versions
+	env.Logger.DebugContext(ctx, "getting secret versions", slog.String("branch", branch))
+	rows, err := env.Database.GetSecretVersions(ctx, database.GetSecretVersionsParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get secret versions", slog.Any("error", err))
+		return GetProjectsNameSecretsVersions500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	versions := make([]SecretVersion, len(rows))
+	for i, row := range rows {
+		versions[i] = secretVersionResponse(row)
+	}
+
+	return GetProjectsNameSecretsVersions200JSONResponse{Versions: versions}, nil
+}
+
+// maxPatchAttempts bounds how often a patch without an expected version is
+// applied again after a concurrent change.
+const maxPatchAttempts = 3
+
+func (Server) PatchProjectsNameSecrets(
+	ctx context.Context, request PatchProjectsNameSecretsRequestObject,
+) (PatchProjectsNameSecretsResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	if request.Body == nil {
+		return PatchProjectsNameSecrets400JSONResponse{
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			Message: "request body is required",
+			ErrorId: requestid,
+		}, nil
+	}
+	var set map[string]string
+	if request.Body.Set != nil {
+		set = *request.Body.Set
+	}
+	var unset []string
+	if request.Body.Unset != nil {
+		unset = *request.Body.Unset
+	}
+	for _, key := range unset {
+		if _, ok := set[key]; ok {
+			return PatchProjectsNameSecrets400JSONResponse{
+				Status:  apierror.BadRequest.Status(),
+				Code:    apierror.BadRequest.String(),
+				Message: fmt.Sprintf("secret %s is both set and unset", key),
+				ErrorId: requestid,
+			}, nil
+		}
+	}
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	}
+	restart := request.Params.Restart == nil || *request.Params.Restart
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PatchProjectsNameSecrets404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PatchProjectsNameSecrets500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get user permissions", slog.Any("error", err))
+		return PatchProjectsNameSecrets500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return PatchProjectsNameSecrets403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to update secrets",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Apply the patch to the latest secrets. Without an expected version the
+	// patch is applied again when the secrets changed in the meantime. The
+	// version is read before the secrets, so a write in between records the
+	// version this attempt expects to write and it conflicts.
+	var write secretsWrite
+	for attempt := 1; ; attempt++ {
+		expected := request.Body.Version
+		if expected == nil {
+			latest, err := env.Database.GetLatestSecretVersion(ctx, database.GetLatestSecretVersionParams{
+				ProjectID:     project.ID,
+				ProjectBranch: branch,
+			})
+			if err != nil {
+				env.Logger.ErrorContext(ctx, "failed to get secret version", slog.Any("error", err))
+				return PatchProjectsNameSecrets500JSONResponse{
+					Status:  apierror.InternalServerError.Status(),
+					Code:    apierror.InternalServerError.String(),
+					Message: "Internal Server Error",
+					ErrorId: requestid,
+				}, nil
+			}
+			expected = &latest
+		}
+
+		values, err := env.Secrets.Get(ctx, project.Name, branch)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to get secrets", slog.Any("error", err))
+			return PatchProjectsNameSecrets500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestid,
+			}, nil
+		}
+		maps.Copy(values, set)
+		for _, key := range unset {
+			delete(values, key)
+		}
+
+		env.Logger.DebugContext(ctx, "patching secrets",
+			slog.String("branch", branch),
+			slog.Int("attempt", attempt))
+		write, err = writeSecrets(ctx, project, branch, values, expected, user, restart, env)
+		if errors.Is(err, errSecretsConflict) && request.Body.Version == nil && attempt < maxPatchAttempts {
+			continue
+		}
+		if errors.Is(err, errSecretsConflict) {
+			return PatchProjectsNameSecrets409JSONResponse{
+				Status:  apierror.SecretsConflict.Status(),
+				Code:    apierror.SecretsConflict.String(),
+				Message: "secrets were changed concurrently, get them again and retry",
+				ErrorId: requestid,
+			}, nil
+		}
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to update secrets", slog.Any("error", err))
+			return PatchProjectsNameSecrets500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestid,
+			}, nil
+		}
+		break
+	}
+
+	return PatchProjectsNameSecrets200JSONResponse{Version: write.Latest, Restarted: write.Restarted}, nil
+}
+
+func (Server) PostProjectsNameSecretsVersionsVersionRollback(
+	ctx context.Context, request PostProjectsNameSecretsVersionsVersionRollbackRequestObject,
+) (PostProjectsNameSecretsVersionsVersionRollbackResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PostProjectsNameSecretsVersionsVersionRollback404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PostProjectsNameSecretsVersionsVersionRollback500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get user permissions", slog.Any("error", err))
+		return PostProjectsNameSecretsVersionsVersionRollback500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return PostProjectsNameSecretsVersionsVersionRollback403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to update secrets",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get version
+	env.Logger.DebugContext(ctx, "getting secret version",
+		slog.String("branch", branch),
+		slog.Int("version", int(request.Version)))
+	target, err := env.Database.GetSecretVersion(ctx, database.GetSecretVersionParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+		Version:       request.Version,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return PostProjectsNameSecretsVersionsVersionRollback404JSONResponse{
+			Status:  apierror.SecretVersionNotFound.Status(),
+			Code:    apierror.SecretVersionNotFound.String(),
+			Message: fmt.Sprintf("secret version %d not found", request.Version),
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get secret version", slog.Any("error", err))
+		return PostProjectsNameSecretsVersionsVersionRollback500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	cipher, err := secrets.NewCipher(env.Config.SecretsKey)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to create cipher", slog.Any("error", err))
+		return PostProjectsNameSecretsVersionsVersionRollback500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	values, err := cipher.DecryptValues(target.Data)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to decrypt secret version", slog.Any("error", err))
+		return PostProjectsNameSecretsVersionsVersionRollback500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	restart := request.Params.Restart == nil || *request.Params.Restart
+
+	// Roll back
+	write, err := writeSecrets(ctx, project, branch, values, nil, user, restart, env)
+	if errors.Is(err, errSecretsConflict) {
+		return PostProjectsNameSecretsVersionsVersionRollback409JSONResponse{
+			Status:  apierror.SecretsConflict.Status(),
+			Code:    apierror.SecretsConflict.String(),
+			Message: "secrets were changed concurrently, retry the rollback",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to roll back secrets", slog.Any("error", err))
+		return PostProjectsNameSecretsVersionsVersionRollback500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// The secrets already match the requested version
+	if !write.Created {
+		return PostProjectsNameSecretsVersionsVersionRollback200JSONResponse{
+			Version: secretVersionResponse(database.GetSecretVersionsRow{
+				Version:   target.Version,
+				Added:     target.Added,
+				Removed:   target.Removed,
+				Changed:   target.Changed,
+				CreatedAt: target.CreatedAt,
+			}),
+			Restarted: write.Restarted,
+		}, nil
+	}
+
+	return PostProjectsNameSecretsVersionsVersionRollback200JSONResponse{
+		Version: secretVersionResponse(database.GetSecretVersionsRow{
+			Version:   write.Version.Version,
+			Added:     write.Version.Added,
+			Removed:   write.Version.Removed,
+			Changed:   write.Version.Changed,
+			CreatedAt: write.Version.CreatedAt,
+			Username:  pgtype.Text{String: user.Username, Valid: true},
+		}),
+		Restarted: write.Restarted,
+	}, nil
+}
+
+func secretVersionResponse(row database.GetSecretVersionsRow) SecretVersion {
+	version := SecretVersion{
+		Version:   row.Version,
+		Added:     row.Added,
+		Removed:   row.Removed,
+		Changed:   row.Changed,
+		CreatedAt: row.CreatedAt.Time,
+	}
+	if row.Username.Valid {
+		version.User = &row.Username.String
+	}
+	return version
+}
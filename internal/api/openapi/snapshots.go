@@ -0,0 +1,383 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	apierror "nimbus/internal/api/error"
+	"nimbus/internal/api/requestid"
+	"nimbus/internal/database"
+	"nimbus/internal/env"
+	"nimbus/internal/kubernetes"
+	"nimbus/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (Server) PostProjectsNameVolumesVolumeSnapshots(
+	ctx context.Context, request PostProjectsNameVolumesVolumeSnapshotsRequestObject,
+) (PostProjectsNameVolumesVolumeSnapshotsResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	} else {
+		branch = "main"
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PostProjectsNameVolumesVolumeSnapshots404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PostProjectsNameVolumesVolumeSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return PostProjectsNameVolumesVolumeSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return PostProjectsNameVolumesVolumeSnapshots403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to snapshot volumes",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get volume
+	env.Logger.DebugContext(ctx, "getting volume",
+		slog.String("volume", request.Volume),
+		slog.String("branch", branch))
+	volume, err := env.Database.GetVolume(ctx, database.GetVolumeParams{
+		VolumeName:    request.Volume,
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return PostProjectsNameVolumesVolumeSnapshots404JSONResponse{
+			Status:  apierror.VolumeNotFound.Status(),
+			Code:    apierror.VolumeNotFound.String(),
+			Message: fmt.Sprintf("volume %s not found", request.Volume),
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get volume", slog.Any("error", err))
+		return PostProjectsNameVolumesVolumeSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Snapshot volume, the identifier is generated up front so the snapshot
+	// resources can be named after it before the row exists
+	id, err := uuid.NewRandom()
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to generate snapshot id", slog.Any("error", err))
+		return PostProjectsNameVolumesVolumeSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	namespace := utils.GetSanitizedNamespace(project.Name, branch)
+	env.Logger.DebugContext(ctx, "creating snapshot", slog.String("snapshot", id.String()))
+	method, err := kubernetes.CreateVolumeSnapshot(
+		ctx, namespace, fmt.Sprintf("pvc-%s", volume.Identifier), id, volume.Size, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to create snapshot", slog.Any("error", err))
+		return PostProjectsNameVolumesVolumeSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	snapshot, err := env.Database.CreateVolumeSnapshot(ctx, database.CreateVolumeSnapshotParams{
+		ID:               id,
+		VolumeIdentifier: volume.Identifier,
+		ProjectID:        project.ID,
+		Method:           method,
+		Size:             volume.Size,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to store snapshot", slog.Any("error", err))
+		_ = kubernetes.DeleteVolumeSnapshot(ctx, namespace, id, method, env)
+		return PostProjectsNameVolumesVolumeSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	return PostProjectsNameVolumesVolumeSnapshots201JSONResponse{
+		Id:        snapshot.ID,
+		Volume:    volume.VolumeName,
+		Branch:    branch,
+		Method:    VolumeSnapshotMethod(snapshot.Method),
+		Size:      snapshot.Size,
+		Ready:     method == kubernetes.SnapshotMethodCopy,
+		CreatedAt: snapshot.CreatedAt.Time,
+	}, nil
+}
+
+func (Server) GetProjectsNameSnapshots(
+	ctx context.Context, request GetProjectsNameSnapshotsRequestObject,
+) (GetProjectsNameSnapshotsResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameSnapshots404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return GetProjectsNameSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return GetProjectsNameSnapshots403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to view snapshots",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get snapshots
+	env.Logger.DebugContext(ctx, "getting snapshots")
+	dbSnapshots, err := env.Database.GetVolumeSnapshotsByProject(ctx, project.ID)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get snapshots", slog.Any("error", err))
+		return GetProjectsNameSnapshots500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	snapshots := make([]VolumeSnapshot, len(dbSnapshots))
+	for i, snapshot := range dbSnapshots {
+		namespace := utils.GetSanitizedNamespace(project.Name, snapshot.ProjectBranch)
+		ready, err := kubernetes.VolumeSnapshotReady(ctx, namespace, snapshot.ID, snapshot.Method, env)
+		if err != nil {
+			env.Logger.WarnContext(ctx, "failed to get snapshot status",
+				slog.String("snapshot", snapshot.ID.String()),
+				slog.Any("error", err))
+		}
+		snapshots[i] = VolumeSnapshot{
+			Id:        snapshot.ID,
+			Volume:    snapshot.VolumeName,
+			Branch:    snapshot.ProjectBranch,
+			Method:    VolumeSnapshotMethod(snapshot.Method),
+			Size:      snapshot.Size,
+			Ready:     ready,
+			CreatedAt: snapshot.CreatedAt.Time,
+		}
+	}
+
+	return GetProjectsNameSnapshots200JSONResponse{
+		Snapshots: &snapshots,
+	}, nil
+}
+
+func (Server) PostProjectsNameSnapshotsSnapshotRestore(
+	ctx context.Context, request PostProjectsNameSnapshotsSnapshotRestoreRequestObject,
+) (PostProjectsNameSnapshotsSnapshotRestoreResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PostProjectsNameSnapshotsSnapshotRestore404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PostProjectsNameSnapshotsSnapshotRestore500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return PostProjectsNameSnapshotsSnapshotRestore500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return PostProjectsNameSnapshotsSnapshotRestore403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to restore snapshots",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get snapshot
+	env.Logger.DebugContext(ctx, "getting snapshot", slog.String("snapshot", request.Snapshot.String()))
+	snapshot, err := env.Database.GetVolumeSnapshot(ctx, database.GetVolumeSnapshotParams{
+		ID:        request.Snapshot,
+		ProjectID: project.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return PostProjectsNameSnapshotsSnapshotRestore404JSONResponse{
+			Status:  apierror.SnapshotNotFound.Status(),
+			Code:    apierror.SnapshotNotFound.String(),
+			Message: fmt.Sprintf("snapshot %s not found", request.Snapshot),
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get snapshot", slog.Any("error", err))
+		return PostProjectsNameSnapshotsSnapshotRestore500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	volume, err := env.Database.GetVolume(ctx, database.GetVolumeParams{
+		VolumeName:    snapshot.VolumeName,
+		ProjectID:     project.ID,
+		ProjectBranch: snapshot.ProjectBranch,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get volume", slog.Any("error", err))
+		return PostProjectsNameSnapshotsSnapshotRestore500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Restore snapshot
+	namespace := utils.GetSanitizedNamespace(project.Name, snapshot.ProjectBranch)
+	env.Logger.DebugContext(ctx, "restoring snapshot",
+		slog.String("snapshot", snapshot.ID.String()),
+		slog.String("namespace", namespace))
+	err = kubernetes.RestoreVolumeSnapshot(ctx, namespace, fmt.Sprintf("pvc-%s", volume.Identifier),
+		snapshot.ID, snapshot.Method, volume.Size, env)
+	if errors.Is(err, kubernetes.ErrSnapshotNotReady) {
+		return PostProjectsNameSnapshotsSnapshotRestore409JSONResponse{
+			Status:  apierror.SnapshotNotReady.Status(),
+			Code:    apierror.SnapshotNotReady.String(),
+			Message: fmt.Sprintf("snapshot %s is not ready yet", snapshot.ID),
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to restore snapshot", slog.Any("error", err))
+		return PostProjectsNameSnapshotsSnapshotRestore500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	return PostProjectsNameSnapshotsSnapshotRestore200JSONResponse{
+		Id:        snapshot.ID,
+		Volume:    snapshot.VolumeName,
+		Branch:    snapshot.ProjectBranch,
+		Method:    VolumeSnapshotMethod(snapshot.Method),
+		Size:      snapshot.Size,
+		Ready:     true,
+		CreatedAt: snapshot.CreatedAt.Time,
+	}, nil
+}
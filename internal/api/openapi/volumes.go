@@ -0,0 +1,607 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	apierror "nimbus/internal/api/error"
+	"nimbus/internal/api/requestid"
+	"nimbus/internal/database"
+	"nimbus/internal/env"
+	"nimbus/internal/kubernetes"
+	"nimbus/internal/utils"
+
+	"github.com/jackc/pgx/v5"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func (Server) GetProjectsNameVolumes(
+	ctx context.Context, request GetProjectsNameVolumesRequestObject,
+) (GetProjectsNameVolumesResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	} else {
+		branch = "main"
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameVolumes404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameVolumes500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return GetProjectsNameVolumes500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return GetProjectsNameVolumes403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to view volumes",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get volumes
+	env.Logger.DebugContext(ctx, "getting volumes", slog.String("branch", branch))
+	dbVolumes, err := env.Database.GetVolumesByBranch(ctx, database.GetVolumesByBranchParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get volumes", slog.Any("error", err))
+		return GetProjectsNameVolumes500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	namespace := utils.GetSanitizedNamespace(project.Name, branch)
+	mounts, err := kubernetes.GetVolumeMounts(ctx, namespace, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get volume mounts", slog.Any("error", err))
+		return GetProjectsNameVolumes500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	volumes := make([]Volume, len(dbVolumes))
+	for i, volume := range dbVolumes {
+		pvcName := fmt.Sprintf("pvc-%s", volume.Identifier)
+		services := mounts[pvcName]
+		if services == nil {
+			services = []string{}
+		}
+		volumes[i] = Volume{
+			Name:       volume.VolumeName,
+			Identifier: volume.Identifier.String(),
+			Size:       volume.Size,
+			Storage:    &volume.Storage,
+			Status:     "Missing",
+			Services:   services,
+		}
+		if volume.DeleteAfter.Valid {
+			volumes[i].DeleteAfter = &volume.DeleteAfter.Time
+		}
+
+		pvc, err := kubernetes.GetPVC(ctx, namespace, pvcName, env)
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to get pvc",
+				slog.String("pvc", pvcName),
+				slog.Any("error", err))
+			return GetProjectsNameVolumes500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestid,
+			}, nil
+		}
+		volumes[i].Status = string(pvc.Status.Phase)
+		if capacity, ok := pvc.Status.Capacity["storage"]; ok {
+			value := capacity.String()
+			volumes[i].Capacity = &value
+		}
+	}
+
+	return GetProjectsNameVolumes200JSONResponse{
+		Volumes: &volumes,
+	}, nil
+}
+
+func (Server) DeleteProjectsNameVolumesVolume(
+	ctx context.Context, request DeleteProjectsNameVolumesVolumeRequestObject,
+) (DeleteProjectsNameVolumesVolumeResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	} else {
+		branch = "main"
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return DeleteProjectsNameVolumesVolume404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return DeleteProjectsNameVolumesVolume500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return DeleteProjectsNameVolumesVolume500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return DeleteProjectsNameVolumesVolume403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to delete volumes",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get volume
+	env.Logger.DebugContext(ctx, "getting volume",
+		slog.String("volume", request.Volume),
+		slog.String("branch", branch))
+	volume, err := env.Database.GetVolume(ctx, database.GetVolumeParams{
+		VolumeName:    request.Volume,
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return DeleteProjectsNameVolumesVolume404JSONResponse{
+			Status:  apierror.VolumeNotFound.Status(),
+			Code:    apierror.VolumeNotFound.String(),
+			Message: fmt.Sprintf("volume %s not found", request.Volume),
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get volume", slog.Any("error", err))
+		return DeleteProjectsNameVolumesVolume500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Refuse to delete volumes that are still mounted
+	namespace := utils.GetSanitizedNamespace(project.Name, branch)
+	pvcName := fmt.Sprintf("pvc-%s", volume.Identifier)
+	mounts, err := kubernetes.GetVolumeMounts(ctx, namespace, env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get volume mounts", slog.Any("error", err))
+		return DeleteProjectsNameVolumesVolume500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if services := mounts[pvcName]; len(services) > 0 {
+		return DeleteProjectsNameVolumesVolume409JSONResponse{
+			Status: apierror.VolumeInUse.Status(),
+			Code:   apierror.VolumeInUse.String(),
+			Message: fmt.Sprintf("volume %s is mounted by %s, remove it from nimbus.yaml and deploy first",
+				request.Volume, strings.Join(services, ", ")),
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Delete snapshots, their rows are removed with the volume
+	snapshots, err := env.Database.GetVolumeSnapshotsByVolume(ctx, volume.Identifier)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get snapshots", slog.Any("error", err))
+		return DeleteProjectsNameVolumesVolume500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	for _, snapshot := range snapshots {
+		err = kubernetes.DeleteVolumeSnapshot(ctx, namespace, snapshot.ID, snapshot.Method, env)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to delete snapshot",
+				slog.String("snapshot", snapshot.ID.String()),
+				slog.Any("error", err))
+		}
+	}
+
+	// Delete volume
+	env.Logger.DebugContext(ctx, "deleting volume", slog.String("pvc", pvcName))
+	err = kubernetes.DeletePVC(ctx, namespace, pvcName, env)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		env.Logger.ErrorContext(ctx, "failed to delete pvc", slog.Any("error", err))
+		return DeleteProjectsNameVolumesVolume500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	err = env.Database.DeleteVolume(ctx, volume.Identifier)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to delete volume", slog.Any("error", err))
+		return DeleteProjectsNameVolumesVolume500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	return DeleteProjectsNameVolumesVolume204Response{}, nil
+}
+
+func (Server) GetProjectsNameVolumesVolumeArchive(
+	ctx context.Context, request GetProjectsNameVolumesVolumeArchiveRequestObject,
+) (GetProjectsNameVolumesVolumeArchiveResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	} else {
+		branch = "main"
+	}
+	volumePath := "/"
+	if request.Params.Path != nil {
+		volumePath = *request.Params.Path
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameVolumesVolumeArchive404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return GetProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return GetProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return GetProjectsNameVolumesVolumeArchive403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to download volumes",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get volume
+	env.Logger.DebugContext(ctx, "getting volume",
+		slog.String("volume", request.Volume),
+		slog.String("branch", branch))
+	volume, err := env.Database.GetVolume(ctx, database.GetVolumeParams{
+		VolumeName:    request.Volume,
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return GetProjectsNameVolumesVolumeArchive404JSONResponse{
+			Status:  apierror.VolumeNotFound.Status(),
+			Code:    apierror.VolumeNotFound.String(),
+			Message: fmt.Sprintf("volume %s not found", request.Volume),
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get volume", slog.Any("error", err))
+		return GetProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Start helper pod
+	namespace := utils.GetSanitizedNamespace(project.Name, branch)
+	env.Logger.DebugContext(ctx, "starting volume helper", slog.String("namespace", namespace))
+	helper, err := kubernetes.StartVolumeHelper(ctx, namespace, fmt.Sprintf("pvc-%s", volume.Identifier), env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to start volume helper", slog.Any("error", err))
+		return GetProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	exists, err := helper.Exists(ctx, volumePath)
+	if err != nil || !exists {
+		_ = helper.Stop(context.WithoutCancel(ctx))
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to check volume path", slog.Any("error", err))
+			return GetProjectsNameVolumesVolumeArchive500JSONResponse{
+				Status:  apierror.InternalServerError.Status(),
+				Code:    apierror.InternalServerError.String(),
+				Message: "Internal Server Error",
+				ErrorId: requestid,
+			}, nil
+		}
+		return GetProjectsNameVolumesVolumeArchive404JSONResponse{
+			Status:  apierror.VolumeNotFound.Status(),
+			Code:    apierror.VolumeNotFound.String(),
+			Message: fmt.Sprintf("path %s not found in volume %s", volumePath, request.Volume),
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Stream archive, the helper is stopped once the archive was written or
+	// the client went away
+	reader, writer := io.Pipe()
+	go func() {
+		err := helper.Archive(ctx, volumePath, writer)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to archive volume", slog.Any("error", err))
+		}
+		_ = writer.CloseWithError(err)
+		err = helper.Stop(context.WithoutCancel(ctx))
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to stop volume helper", slog.Any("error", err))
+		}
+	}()
+
+	return GetProjectsNameVolumesVolumeArchive200ApplicationgzipResponse{
+		Body: reader,
+	}, nil
+}
+
+func (Server) PutProjectsNameVolumesVolumeArchive(
+	ctx context.Context, request PutProjectsNameVolumesVolumeArchiveRequestObject,
+) (PutProjectsNameVolumesVolumeArchiveResponseObject, error) {
+	env := env.FromContext(ctx)
+	requestid := fmt.Sprintf("%d", requestid.FromContext(ctx))
+	user := database.UserFromContext(ctx)
+
+	if request.Body == nil {
+		return PutProjectsNameVolumesVolumeArchive400JSONResponse{
+			Status:  apierror.BadRequest.Status(),
+			Code:    apierror.BadRequest.String(),
+			Message: "request body is required",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	var branch string
+	if request.Params.Branch != nil {
+		branch = *request.Params.Branch
+	} else {
+		branch = "main"
+	}
+	volumePath := "/"
+	if request.Params.Path != nil {
+		volumePath = *request.Params.Path
+	}
+
+	// Get project
+	env.Logger.DebugContext(ctx, "getting project")
+	project, err := env.Database.GetProjectByName(ctx, request.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PutProjectsNameVolumesVolumeArchive404JSONResponse{
+			Status:  apierror.ProjectNotFound.Status(),
+			Code:    apierror.ProjectNotFound.String(),
+			Message: "project not found",
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get project", slog.Any("error", err))
+		return PutProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Check permissions
+	env.Logger.DebugContext(ctx, "getting user permissions")
+	authorized, err := env.Database.IsUserInProject(ctx, database.IsUserInProjectParams{
+		UserID:    user.ID,
+		ProjectID: project.ID,
+	})
+	if err != nil {
+		env.Logger.ErrorContext(
+			ctx, "failed to get user permissions", slog.Any("error", err))
+		return PutProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	if !authorized {
+		env.Logger.ErrorContext(ctx, "user does not have permissions")
+		return PutProjectsNameVolumesVolumeArchive403JSONResponse{
+			Status:  apierror.InsufficientPermissions.Status(),
+			Code:    apierror.InsufficientPermissions.String(),
+			Message: "user does not have permission to upload to volumes",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Get volume
+	env.Logger.DebugContext(ctx, "getting volume",
+		slog.String("volume", request.Volume),
+		slog.String("branch", branch))
+	volume, err := env.Database.GetVolume(ctx, database.GetVolumeParams{
+		VolumeName:    request.Volume,
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return PutProjectsNameVolumesVolumeArchive404JSONResponse{
+			Status:  apierror.VolumeNotFound.Status(),
+			Code:    apierror.VolumeNotFound.String(),
+			Message: fmt.Sprintf("volume %s not found", request.Volume),
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to get volume", slog.Any("error", err))
+		return PutProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	// Start helper pod
+	namespace := utils.GetSanitizedNamespace(project.Name, branch)
+	env.Logger.DebugContext(ctx, "starting volume helper", slog.String("namespace", namespace))
+	helper, err := kubernetes.StartVolumeHelper(ctx, namespace, fmt.Sprintf("pvc-%s", volume.Identifier), env)
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to start volume helper", slog.Any("error", err))
+		return PutProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+	defer func() {
+		err := helper.Stop(context.WithoutCancel(ctx))
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to stop volume helper", slog.Any("error", err))
+		}
+	}()
+
+	// Extract archive
+	env.Logger.DebugContext(ctx, "extracting archive", slog.String("path", volumePath))
+	err = helper.Extract(ctx, volumePath, request.Body)
+	if errors.Is(err, kubernetes.ErrExtractFailed) {
+		return PutProjectsNameVolumesVolumeArchive422JSONResponse{
+			Status:  apierror.UnprocessibleContent.Status(),
+			Code:    apierror.UnprocessibleContent.String(),
+			Message: err.Error(),
+			ErrorId: requestid,
+		}, nil
+	}
+	if err != nil {
+		env.Logger.ErrorContext(ctx, "failed to extract archive", slog.Any("error", err))
+		return PutProjectsNameVolumesVolumeArchive500JSONResponse{
+			Status:  apierror.InternalServerError.Status(),
+			Code:    apierror.InternalServerError.String(),
+			Message: "Internal Server Error",
+			ErrorId: requestid,
+		}, nil
+	}
+
+	return PutProjectsNameVolumesVolumeArchive204Response{}, nil
+}
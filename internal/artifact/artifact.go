@@ -0,0 +1,228 @@
+// Package artifact contains functions for packing and unpacking gzipped
+// tarballs of build artifacts and volume contents.
+package artifact
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Archive writes the contents of dir to w as a gzipped tarball. Paths in
+// the archive are relative to dir.
+func Archive(dir string, w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil // skip symlinks, devices and sockets
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = file.Close() }()
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("archiving %s: %w", dir, err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("closing tar writer: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("closing gzip writer: %w", err)
+	}
+	return nil
+}
+
+// ArchiveFile writes the file at path to w as a gzipped tarball holding only
+// that file under its base name.
+func ArchiveFile(path string, w io.Writer) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("archiving %s: %w", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("archiving %s: not a regular file", path)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("archiving %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return fmt.Errorf("archiving %s: %w", path, err)
+	}
+	header.Name = filepath.Base(path)
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("archiving %s: %w", path, err)
+	}
+	if _, err := io.Copy(tw, file); err != nil {
+		return fmt.Errorf("archiving %s: %w", path, err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("closing tar writer: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("closing gzip writer: %w", err)
+	}
+	return nil
+}
+
+// Extract unpacks the gzipped tarball read from r into dir. Entries which
+// would be written outside of dir are rejected.
+func Extract(r io.Reader, dir string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("opening gzip reader: %w", err)
+	}
+	defer func() { _ = gr.Close() }()
+
+	root := filepath.Clean(dir)
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading archive: %w", err)
+		}
+
+		target := filepath.Join(root, filepath.FromSlash(header.Name))
+		if target != root && !strings.HasPrefix(target, root+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil { //nolint:mnd
+				return fmt.Errorf("creating directory %s: %w", header.Name, err)
+			}
+		case tar.TypeReg:
+			if err := extractFile(tr, target, header.FileInfo().Mode().Perm()); err != nil {
+				return fmt.Errorf("extracting %s: %w", header.Name, err)
+			}
+		default:
+			continue // skip links and special files
+		}
+	}
+}
+
+func extractFile(r io.Reader, target string, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil { //nolint:mnd
+		return err
+	}
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+	_, err = io.Copy(file, r)
+	return err
+}
+
+// Replace replaces the contents of dir with the gzipped tarball read from r.
+// The archive is extracted into a staging directory inside dir first and
+// only moved into place once extraction succeeded, so a failed or corrupt
+// upload leaves the previous contents untouched.
+func Replace(r io.Reader, dir string) error {
+	staging, err := os.MkdirTemp(dir, ".nimbus-staging-")
+	if err != nil {
+		return fmt.Errorf("creating staging directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(staging) }()
+	if err := Extract(r, staging); err != nil {
+		return err
+	}
+
+	previous, err := os.MkdirTemp(dir, ".nimbus-previous-")
+	if err != nil {
+		return fmt.Errorf("creating backup directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(previous) }()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading directory: %w", err)
+	}
+	var moved []string
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if path == staging || path == previous {
+			continue
+		}
+		if err := os.Rename(path, filepath.Join(previous, entry.Name())); err != nil {
+			restore(previous, dir, moved)
+			return fmt.Errorf("moving %s aside: %w", entry.Name(), err)
+		}
+		moved = append(moved, entry.Name())
+	}
+
+	extracted, err := os.ReadDir(staging)
+	if err != nil {
+		restore(previous, dir, moved)
+		return fmt.Errorf("reading staging directory: %w", err)
+	}
+	for i, entry := range extracted {
+		err := os.Rename(filepath.Join(staging, entry.Name()), filepath.Join(dir, entry.Name()))
+		if err != nil {
+			for _, placed := range extracted[:i] {
+				_ = os.RemoveAll(filepath.Join(dir, placed.Name()))
+			}
+			restore(previous, dir, moved)
+			return fmt.Errorf("moving %s into place: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+// restore moves the named entries back from the backup directory.
+func restore(backup, dir string, names []string) {
+	for _, name := range names {
+		_ = os.Rename(filepath.Join(backup, name), filepath.Join(dir, name))
+	}
+}
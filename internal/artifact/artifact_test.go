@@ -0,0 +1,143 @@
+package artifact
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestArchiveExtract(t *testing.T) {
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "assets"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"index.html":     "<html></html>",
+		"assets/app.js":  "console.log('hi')",
+		"assets/app.css": "body{}",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(src, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := Archive(src, &buf); err != nil {
+		t.Fatalf("unexpected error archiving: %v", err)
+	}
+
+	dst := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dst, "stale.html"), []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := Replace(&buf, dst); err != nil {
+		t.Fatalf("unexpected error replacing: %v", err)
+	}
+
+	for name, content := range files {
+		data, err := os.ReadFile(filepath.Join(dst, name))
+		if err != nil {
+			t.Errorf("expected %s to be extracted: %v", name, err)
+			continue
+		}
+		if string(data) != content {
+			t.Errorf("expected %s to contain %q, got %q", name, content, string(data))
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dst, "stale.html")); !os.IsNotExist(err) {
+		t.Errorf("expected stale.html to be cleared")
+	}
+	entries, err := os.ReadDir(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".nimbus-") {
+			t.Errorf("expected %s to be removed", entry.Name())
+		}
+	}
+}
+
+func TestReplaceKeepsContentsOnFailure(t *testing.T) {
+	dst := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dst, "index.html"), []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Replace(strings.NewReader("not a tarball"), dst); err == nil {
+		t.Fatal("expected error for invalid archive")
+	}
+	data, err := os.ReadFile(filepath.Join(dst, "index.html"))
+	if err != nil || string(data) != "old" {
+		t.Errorf("expected index.html to be kept, got %q (%v)", string(data), err)
+	}
+	entries, err := os.ReadDir(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected only index.html to remain, got %d entries", len(entries))
+	}
+}
+
+func TestArchiveFile(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "dump.sql")
+	if err := os.WriteFile(src, []byte("SELECT 1;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := ArchiveFile(src, &buf); err != nil {
+		t.Fatalf("unexpected error archiving: %v", err)
+	}
+
+	dst := t.TempDir()
+	if err := Extract(&buf, dst); err != nil {
+		t.Fatalf("unexpected error extracting: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dst, "dump.sql"))
+	if err != nil {
+		t.Fatalf("expected dump.sql to be extracted: %v", err)
+	}
+	if string(data) != "SELECT 1;" {
+		t.Errorf("expected dump.sql to contain %q, got %q", "SELECT 1;", string(data))
+	}
+
+	if err := ArchiveFile(t.TempDir(), &buf); err == nil {
+		t.Error("expected error archiving a directory")
+	}
+}
+
+func TestExtractRejectsTraversal(t *testing.T) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	content := []byte("pwned")
+	err := tw.WriteHeader(&tar.Header{
+		Name:     "../escape.txt",
+		Mode:     0o644,
+		Size:     int64(len(content)),
+		Typeflag: tar.TypeReg,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	_ = tw.Close()
+	_ = gw.Close()
+
+	dir := t.TempDir()
+	if err := Extract(&buf, filepath.Join(dir, "site")); err == nil {
+		t.Fatal("expected error for path outside of target directory")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escape.txt")); !os.IsNotExist(err) {
+		t.Error("expected escape.txt not to be written")
+	}
+}
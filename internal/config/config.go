@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 
+	"nimbus/internal/secrets"
+
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
@@ -34,11 +37,31 @@ type Database struct {
 	Password string `validate:"required"`
 }
 
+// Ingress holds the server wide defaults for public http services, which
+// services can override in their ingress block.
+type Ingress struct {
+	Namespace    string
+	Class        string
+	CorsOrigins  string
+	MaxBodySize  string `validate:"omitempty,size"`
+	ProxyTimeout string `validate:"omitempty,number"`
+	RateLimit    string `validate:"omitempty,number"`
+}
+
 type Config struct {
 	Environment        string `validate:"omitempty,oneof=development production"`
 	Domain             string `validate:"required,hostname_rfc1123"`
 	NimbusStorageClass string
+	StorageTiers       string `validate:"omitempty,storagetiers"`
+	TLSIssuer          string
+	NodePortRange      string `validate:"required,portrange"`
+	NimbusNamespace    string
+	NetworkPolicies    string   `validate:"required,boolean"`
+	SecretsKey         string   `validate:"secretskey"`
+	SecretsBackend     string   `validate:"required,secretsbackend"`
+	SecretsFile        string   `validate:"required_if=SecretsBackend file"`
 	Database           Database `validate:"required"`
+	Ingress            Ingress
 }
 
 func loadWithDefault(key, def string) string {
@@ -54,6 +77,14 @@ func LoadConfig() (Config, error) {
 		Environment:        loadWithDefault("ENVIRONMENT", "development"),
 		Domain:             loadWithDefault("DOMAIN", ""),
 		NimbusStorageClass: loadWithDefault("NIMBUS_STORAGE_CLASS", ""),
+		StorageTiers:       loadWithDefault("STORAGE_TIERS", ""),
+		TLSIssuer:          loadWithDefault("TLS_ISSUER", "letsencrypt-prod"),
+		NodePortRange:      loadWithDefault("NODE_PORT_RANGE", "30000-32767"),
+		NimbusNamespace:    loadWithDefault("NIMBUS_NAMESPACE", "nimbus"),
+		NetworkPolicies:    loadWithDefault("NETWORK_POLICIES", "true"),
+		SecretsKey:         loadWithDefault("SECRETS_KEY", ""),
+		SecretsBackend:     loadWithDefault("SECRETS_BACKEND", secrets.BackendPostgres),
+		SecretsFile:        loadWithDefault("SECRETS_FILE", "secrets.json"),
 		Database: Database{
 			Host:     loadWithDefault("DB_HOST", ""),
 			Port:     loadWithDefault("DB_PORT", "5432"),
@@ -61,6 +92,14 @@ func LoadConfig() (Config, error) {
 			User:     loadWithDefault("DB_USER", ""),
 			Password: loadWithDefault("DB_PASSWORD", ""),
 		},
+		Ingress: Ingress{
+			Namespace:    loadWithDefault("INGRESS_NAMESPACE", "ingress-nginx"),
+			Class:        loadWithDefault("INGRESS_CLASS", ""),
+			CorsOrigins:  loadWithDefault("INGRESS_CORS_ORIGINS", ""),
+			MaxBodySize:  loadWithDefault("INGRESS_MAX_BODY_SIZE", ""),
+			ProxyTimeout: loadWithDefault("INGRESS_PROXY_TIMEOUT", ""),
+			RateLimit:    loadWithDefault("INGRESS_RATE_LIMIT", ""),
+		},
 	}
 
 	trans, found := uni.GetTranslator("en")
@@ -70,6 +109,11 @@ func LoadConfig() (Config, error) {
 
 	_ = en_translations.RegisterDefaultTranslations(validate, trans)
 	_ = validate.RegisterValidation("port", validatePort)
+	_ = validate.RegisterValidation("size", validateSize)
+	_ = validate.RegisterValidation("portrange", validatePortRange)
+	_ = validate.RegisterValidation("storagetiers", validateStorageTiers)
+	_ = validate.RegisterValidation("secretskey", validateSecretsKey)
+	_ = validate.RegisterValidation("secretsbackend", validateSecretsBackend)
 	_ = validate.RegisterTranslation("required", trans, func(ut ut.Translator) error {
 		return ut.Add("required", "environment variable {0} is required", true)
 	}, func(ut ut.Translator, fe validator.FieldError) string {
@@ -89,6 +133,55 @@ func LoadConfig() (Config, error) {
 		t, _ := ut.T("hostname_rfc1123", toEnvName(fe.StructNamespace()))
 		return fmt.Sprintf("invalid %s (%s) - expected a valid hostname", t, fe.Value())
 	})
+	_ = validate.RegisterTranslation("portrange", trans, func(ut ut.Translator) error {
+		return ut.Add("portrange", "{0}", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("portrange", toEnvName(fe.StructNamespace()))
+		return fmt.Sprintf("invalid %s (%s) - expected a range such as 30000-32767", t, fe.Value())
+	})
+	_ = validate.RegisterTranslation("storagetiers", trans, func(ut ut.Translator) error {
+		return ut.Add("storagetiers", "{0}", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("storagetiers", toEnvName(fe.StructNamespace()))
+		return fmt.Sprintf("invalid %s (%s) - expected tiers such as fast=local-path:RWO,shared=nfs-client:RWX",
+			t, fe.Value())
+	})
+	_ = validate.RegisterTranslation("secretskey", trans, func(ut ut.Translator) error {
+		return ut.Add("secretskey", "{0}", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("secretskey", toEnvName(fe.StructNamespace()))
+		if fe.Value() == "" {
+			return fmt.Sprintf("environment variable %s is required, secrets and their history are encrypted with it "+
+				"for every backend - generate one with openssl rand -base64 32 and keep it safe", t)
+		}
+		return fmt.Sprintf("invalid %s - expected 32 base64 encoded bytes such as the output of openssl rand -base64 32",
+			t)
+	})
+	_ = validate.RegisterTranslation("secretsbackend", trans, func(ut ut.Translator) error {
+		return ut.Add("secretsbackend", "{0}", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("secretsbackend", toEnvName(fe.StructNamespace()))
+		return fmt.Sprintf("invalid %s (%s) - expected one of %s",
+			t, fe.Value(), strings.Join(secrets.Backends(), ", "))
+	})
+	_ = validate.RegisterTranslation("boolean", trans, func(ut ut.Translator) error {
+		return ut.Add("boolean", "{0}", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("boolean", toEnvName(fe.StructNamespace()))
+		return fmt.Sprintf("invalid %s (%s) - expected true or false", t, fe.Value())
+	})
+	_ = validate.RegisterTranslation("size", trans, func(ut ut.Translator) error {
+		return ut.Add("size", "{0}", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("size", toEnvName(fe.StructNamespace()))
+		return fmt.Sprintf("invalid %s (%s) - expected a size such as 10m", t, fe.Value())
+	})
+	_ = validate.RegisterTranslation("number", trans, func(ut ut.Translator) error {
+		return ut.Add("number", "{0}", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("number", toEnvName(fe.StructNamespace()))
+		return fmt.Sprintf("invalid %s (%s) - expected a number", t, fe.Value())
+	})
 	_ = validate.RegisterTranslation("oneof", trans, func(ut ut.Translator) error {
 		return ut.Add("oneof", "{0}", true)
 	}, func(ut ut.Translator, fe validator.FieldError) string {
@@ -118,6 +211,10 @@ func toEnvName(field string) string {
 	if found {
 		sb.WriteString("DB_")
 	}
+	field, found = strings.CutPrefix(field, "Ingress.")
+	if found {
+		sb.WriteString("INGRESS_")
+	}
 
 	for idx := range len(field) {
 		char := string(field[idx])
@@ -130,6 +227,123 @@ func toEnvName(field string) string {
 	return sb.String()
 }
 
+// sizePattern matches sizes in the nginx format, e.g. 512k or 10m.
+var sizePattern = regexp.MustCompile(`^[0-9]+[kKmMgG]?$`)
+
+func validateSize(fl validator.FieldLevel) bool {
+	return sizePattern.MatchString(fl.Field().String())
+}
+
+// ParsePortRange parses an inclusive port range such as 30000-32767.
+func ParsePortRange(value string) (int32, int32, error) {
+	first, last, found := strings.Cut(value, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid port range %s", value)
+	}
+	lower, err := strconv.ParseUint(strings.TrimSpace(first), 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port range %s: %w", value, err)
+	}
+	upper, err := strconv.ParseUint(strings.TrimSpace(last), 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port range %s: %w", value, err)
+	}
+	if lower == 0 || lower > upper {
+		return 0, 0, fmt.Errorf("invalid port range %s", value)
+	}
+	return int32(lower), int32(upper), nil
+}
+
+func validatePortRange(fl validator.FieldLevel) bool {
+	_, _, err := ParsePortRange(fl.Field().String())
+	return err == nil
+}
+
+const (
+	// AccessModeRWO volumes can be mounted by the pods of a single node.
+	AccessModeRWO = "RWO"
+	// AccessModeRWX volumes can be mounted by pods on any node.
+	AccessModeRWX = "RWX"
+)
+
+// StorageTier is a storage class volumes can be placed on.
+type StorageTier struct {
+	Class      string
+	AccessMode string
+}
+
+// ReadWriteOnce reports whether volumes of the tier can only be mounted by
+// the pods of a single node.
+func (t StorageTier) ReadWriteOnce() bool {
+	return t.AccessMode == AccessModeRWO
+}
+
+// ParseStorageTiers parses named storage tiers such as
+// fast=local-path:RWO,shared=nfs-client:RWX. The access mode defaults to RWX.
+func ParseStorageTiers(value string) (map[string]StorageTier, error) {
+	tiers := make(map[string]StorageTier)
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, spec, found := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid storage tier %s", entry)
+		}
+		if _, exists := tiers[name]; exists {
+			return nil, fmt.Errorf("duplicate storage tier %s", name)
+		}
+		class, mode, found := strings.Cut(spec, ":")
+		class = strings.TrimSpace(class)
+		mode = strings.ToUpper(strings.TrimSpace(mode))
+		if !found {
+			mode = AccessModeRWX
+		}
+		if class == "" || (mode != AccessModeRWO && mode != AccessModeRWX) {
+			return nil, fmt.Errorf("invalid storage tier %s", entry)
+		}
+		tiers[name] = StorageTier{Class: class, AccessMode: mode}
+	}
+	return tiers, nil
+}
+
+func validateStorageTiers(fl validator.FieldLevel) bool {
+	_, err := ParseStorageTiers(fl.Field().String())
+	return err == nil
+}
+
+// StorageTier returns the storage tier with the given name. Volumes without a
+// tier use NIMBUS_STORAGE_CLASS with RWX access.
+func (c *Config) StorageTier(name string) (StorageTier, bool) {
+	if name == "" {
+		return StorageTier{Class: c.NimbusStorageClass, AccessMode: AccessModeRWX}, true
+	}
+	tiers, err := ParseStorageTiers(c.StorageTiers)
+	if err != nil {
+		return StorageTier{}, false
+	}
+	tier, ok := tiers[name]
+	return tier, ok
+}
+
+// NetworkPoliciesEnabled reports whether namespaces are isolated with
+// network policies.
+func (c *Config) NetworkPoliciesEnabled() bool {
+	enabled, err := strconv.ParseBool(c.NetworkPolicies)
+	return err == nil && enabled
+}
+
+func validateSecretsKey(fl validator.FieldLevel) bool {
+	_, err := secrets.ParseKey(fl.Field().String())
+	return err == nil
+}
+
+func validateSecretsBackend(fl validator.FieldLevel) bool {
+	return secrets.IsBackend(fl.Field().String())
+}
+
 func validatePort(fl validator.FieldLevel) bool {
 	v, err := strconv.ParseUint(fl.Field().String(), 10, 16)
 	return err == nil && v > 0
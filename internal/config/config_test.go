@@ -5,6 +5,9 @@ import (
 	"testing"
 )
 
+// testSecretsKey is a valid SECRETS_KEY.
+const testSecretsKey = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+
 func TestLoadConfig(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -18,6 +21,12 @@ func TestLoadConfig(t *testing.T) {
 				t.Setenv("ENVIRONMENT", "development")
 				t.Setenv("DOMAIN", "example.com")
 				t.Setenv("NIMBUS_STORAGE_CLASS", "nfs")
+				t.Setenv("TLS_ISSUER", "letsencrypt-staging")
+				t.Setenv("INGRESS_CLASS", "nginx")
+				t.Setenv("INGRESS_MAX_BODY_SIZE", "10m")
+				t.Setenv("INGRESS_PROXY_TIMEOUT", "120")
+				t.Setenv("NODE_PORT_RANGE", "31000-31999")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
 				t.Setenv("DB_HOST", "localhost")
 				t.Setenv("DB_PORT", "5432")
 				t.Setenv("DB_NAME", "nimbus")
@@ -35,6 +44,21 @@ func TestLoadConfig(t *testing.T) {
 				if config.NimbusStorageClass != "nfs" {
 					t.Errorf("expected NimbusStorageClass %s, got %s", "nfs", config.NimbusStorageClass)
 				}
+				if config.TLSIssuer != "letsencrypt-staging" {
+					t.Errorf("expected TLSIssuer %s, got %s", "letsencrypt-staging", config.TLSIssuer)
+				}
+				if config.NodePortRange != "31000-31999" {
+					t.Errorf("expected NodePortRange %s, got %s", "31000-31999", config.NodePortRange)
+				}
+				if config.Ingress.Class != "nginx" {
+					t.Errorf("expected INGRESS_CLASS %s, got %s", "nginx", config.Ingress.Class)
+				}
+				if config.Ingress.MaxBodySize != "10m" {
+					t.Errorf("expected INGRESS_MAX_BODY_SIZE %s, got %s", "10m", config.Ingress.MaxBodySize)
+				}
+				if config.Ingress.ProxyTimeout != "120" {
+					t.Errorf("expected INGRESS_PROXY_TIMEOUT %s, got %s", "120", config.Ingress.ProxyTimeout)
+				}
 				if config.Database.Host != "localhost" {
 					t.Errorf("expected DB_HOST %s, got %s", "localhost", config.Database.Host)
 				}
@@ -50,6 +74,9 @@ func TestLoadConfig(t *testing.T) {
 				if config.Database.Password != "password" {
 					t.Errorf("expected DB_PASSWORD %s, got %s", "password", config.Database.Password)
 				}
+				if config.SecretsBackend != "postgres" {
+					t.Errorf("expected SECRETS_BACKEND %s, got %s", "postgres", config.SecretsBackend)
+				}
 			},
 		},
 		{
@@ -57,6 +84,7 @@ func TestLoadConfig(t *testing.T) {
 			setup: func(t *testing.T) {
 				t.Setenv("ENVIRONMENT", "production")
 				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
 				t.Setenv("DB_HOST", "db.example.com")
 				t.Setenv("DB_PORT", "5432")
 				t.Setenv("DB_NAME", "nimbus")
@@ -74,6 +102,7 @@ func TestLoadConfig(t *testing.T) {
 			name: "valid config - defaults to development",
 			setup: func(t *testing.T) {
 				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
 				t.Setenv("DB_HOST", "localhost")
 				t.Setenv("DB_NAME", "nimbus")
 				t.Setenv("DB_USER", "nimbus")
@@ -87,6 +116,18 @@ func TestLoadConfig(t *testing.T) {
 				if config.Database.Port != "5432" {
 					t.Errorf("expected default DB_PORT %s, got %s", "5432", config.Database.Port)
 				}
+				if config.TLSIssuer != "letsencrypt-prod" {
+					t.Errorf("expected default TLSIssuer %s, got %s", "letsencrypt-prod", config.TLSIssuer)
+				}
+				if !config.NetworkPoliciesEnabled() {
+					t.Error("expected network policies to be enabled by default")
+				}
+				if config.Ingress.Namespace != "ingress-nginx" {
+					t.Errorf("expected default INGRESS_NAMESPACE %s, got %s", "ingress-nginx", config.Ingress.Namespace)
+				}
+				if config.NodePortRange != "30000-32767" {
+					t.Errorf("expected default NodePortRange %s, got %s", "30000-32767", config.NodePortRange)
+				}
 			},
 		},
 		{
@@ -214,6 +255,128 @@ func TestLoadConfig(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "invalid ingress max body size",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+				t.Setenv("INGRESS_MAX_BODY_SIZE", "ten megabytes")
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid ingress rate limit",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+				t.Setenv("INGRESS_RATE_LIMIT", "-5")
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid node port range",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+				t.Setenv("NODE_PORT_RANGE", "32767-30000")
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid storage tiers",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+				t.Setenv("STORAGE_TIERS", "fast=local-path:RWM")
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid secrets key",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", "c2hvcnQ=")
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid secrets backend",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
+				t.Setenv("SECRETS_BACKEND", "vault")
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+			},
+			wantError: true,
+		},
+		{
+			name: "valid network policies value - 0",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+				t.Setenv("NETWORK_POLICIES", "0")
+			},
+			wantError: false,
+			validate: func(t *testing.T, config *Config) {
+				if config.NetworkPoliciesEnabled() {
+					t.Error("expected network policies to be disabled")
+				}
+			},
+		},
+		{
+			name: "valid network policies value - TRUE",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+				t.Setenv("NETWORK_POLICIES", "TRUE")
+			},
+			wantError: false,
+			validate: func(t *testing.T, config *Config) {
+				if !config.NetworkPoliciesEnabled() {
+					t.Error("expected network policies to be enabled")
+				}
+			},
+		},
+		{
+			name: "invalid network policies value",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+				t.Setenv("NETWORK_POLICIES", "sometimes")
+			},
+			wantError: true,
+		},
 		{
 			name: "invalid hostname - special characters",
 			setup: func(t *testing.T) {
@@ -252,6 +415,32 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestParseStorageTiers(t *testing.T) {
+	tiers, err := ParseStorageTiers("fast=local-path:RWO, shared=nfs-client:rwx,archive=slow")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]StorageTier{
+		"fast":    {Class: "local-path", AccessMode: AccessModeRWO},
+		"shared":  {Class: "nfs-client", AccessMode: AccessModeRWX},
+		"archive": {Class: "slow", AccessMode: AccessModeRWX},
+	}
+	if len(tiers) != len(expected) {
+		t.Fatalf("expected %d tiers, got %d", len(expected), len(tiers))
+	}
+	for name, tier := range expected {
+		if tiers[name] != tier {
+			t.Errorf("expected tier %s to be %+v, got %+v", name, tier, tiers[name])
+		}
+	}
+
+	for _, value := range []string{"fast", "=local-path", "fast=:RWO", "fast=a,fast=b", "fast=local-path:ROX"} {
+		if _, err := ParseStorageTiers(value); err == nil {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+}
+
 func TestToEnvName(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -268,6 +457,11 @@ func TestToEnvName(t *testing.T) {
 			input: "Config.NimbusStorageClass",
 			want:  "NIMBUS_STORAGE_CLASS",
 		},
+		{
+			name:  "ingress field - MaxBodySize",
+			input: "Config.Ingress.MaxBodySize",
+			want:  "INGRESS_MAX_BODY_SIZE",
+		},
 		{
 			name:  "database field - Host",
 			input: "Config.Database.Host",
@@ -380,6 +574,18 @@ func TestErrorMessages(t *testing.T) {
 			},
 			expectedError: "environment variable DB_PASSWORD is required",
 		},
+		{
+			name: "friendly error for missing secrets key",
+			setup: func(t *testing.T) {
+				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_BACKEND", "file")
+				t.Setenv("DB_HOST", "localhost")
+				t.Setenv("DB_NAME", "nimbus")
+				t.Setenv("DB_USER", "nimbus")
+				t.Setenv("DB_PASSWORD", "password")
+			},
+			expectedError: "environment variable SECRETS_KEY is required, secrets and their history are encrypted",
+		},
 	}
 
 	for _, tt := range tests {
@@ -409,6 +615,7 @@ func TestValidatePort(t *testing.T) {
 			setup: func(t *testing.T) {
 				t.Setenv("ENVIRONMENT", "development")
 				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
 				t.Setenv("DB_HOST", "localhost")
 				t.Setenv("DB_PORT", "5432")
 				t.Setenv("DB_NAME", "nimbus")
@@ -422,6 +629,7 @@ func TestValidatePort(t *testing.T) {
 			setup: func(t *testing.T) {
 				t.Setenv("ENVIRONMENT", "development")
 				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
 				t.Setenv("DB_HOST", "localhost")
 				t.Setenv("DB_PORT", "1")
 				t.Setenv("DB_NAME", "nimbus")
@@ -435,6 +643,7 @@ func TestValidatePort(t *testing.T) {
 			setup: func(t *testing.T) {
 				t.Setenv("ENVIRONMENT", "development")
 				t.Setenv("DOMAIN", "example.com")
+				t.Setenv("SECRETS_KEY", testSecretsKey)
 				t.Setenv("DB_HOST", "localhost")
 				t.Setenv("DB_PORT", "65535")
 				t.Setenv("DB_NAME", "nimbus")
@@ -9,11 +9,58 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Certificate struct {
+	Domain     string
+	ProjectID  uuid.UUID
+	SecretName string
+	NotAfter   pgtype.Timestamptz
+}
+
+type Domain struct {
+	Domain      string
+	ProjectID   uuid.UUID
+	ServiceName string
+}
+
+type NodePort struct {
+	NodePort      int32
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	ServiceName   string
+	Port          int32
+	Protocol      string
+}
+
+type PreviewProtection struct {
+	ProjectID uuid.UUID
+	Mode      string
+	Allowlist []string
+}
+
 type Project struct {
 	ID   uuid.UUID
 	Name string
 }
 
+type ProjectSecret struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	Data          []byte
+	UpdatedAt     pgtype.Timestamptz
+}
+
+type SecretVersion struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	Version       int32
+	Data          []byte
+	UserID        pgtype.UUID
+	Added         []string
+	Removed       []string
+	Changed       []string
+	CreatedAt     pgtype.Timestamptz
+}
+
 type Service struct {
 	ID            uuid.UUID
 	ProjectID     uuid.UUID
@@ -40,4 +87,15 @@ type Volume struct {
 	ProjectID     uuid.UUID
 	ProjectBranch string
 	Size          int32
+	Storage       string
+	DeleteAfter   pgtype.Timestamptz
+}
+
+type VolumeSnapshot struct {
+	ID               uuid.UUID
+	VolumeIdentifier uuid.UUID
+	ProjectID        uuid.UUID
+	Method           string
+	Size             int32
+	CreatedAt        pgtype.Timestamptz
 }
@@ -8,34 +8,72 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	AddUserToProject(ctx context.Context, arg AddUserToProjectParams) error
-	CheckProjectsTableExists(ctx context.Context) (bool, error)
 	CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error)
+	CreateSecretVersion(ctx context.Context, arg CreateSecretVersionParams) (SecretVersion, error)
 	CreateService(ctx context.Context, arg CreateServiceParams) (Service, error)
 	CreateVolume(ctx context.Context, arg CreateVolumeParams) (Volume, error)
+	CreateVolumeSnapshot(ctx context.Context, arg CreateVolumeSnapshotParams) (VolumeSnapshot, error)
+	DeletePreviewProtection(ctx context.Context, projectID uuid.UUID) error
 	DeleteProject(ctx context.Context, id uuid.UUID) error
+	DeleteProjectSecrets(ctx context.Context, arg DeleteProjectSecretsParams) error
+	DeleteSecretVersion(ctx context.Context, arg DeleteSecretVersionParams) error
+	DeleteSecretVersionsByBranch(ctx context.Context, arg DeleteSecretVersionsByBranchParams) error
 	DeleteServiceById(ctx context.Context, id uuid.UUID) error
 	DeleteServiceByName(ctx context.Context, arg DeleteServiceByNameParams) error
+	DeleteUnusedDomains(ctx context.Context, arg DeleteUnusedDomainsParams) error
 	DeleteUnusedVolumes(ctx context.Context, arg DeleteUnusedVolumesParams) error
+	DeleteVolume(ctx context.Context, identifier uuid.UUID) error
+	GetAllocatedNodePorts(ctx context.Context) ([]int32, error)
 	GetApiKeyExistance(ctx context.Context, apiKey string) (bool, error)
+	GetCertificate(ctx context.Context, domain string) (Certificate, error)
+	GetCertificatesByProject(ctx context.Context, projectID uuid.UUID) ([]Certificate, error)
+	GetDomain(ctx context.Context, domain string) (Domain, error)
+	GetExpiredVolumes(ctx context.Context) ([]Volume, error)
+	GetLatestSecretVersion(ctx context.Context, arg GetLatestSecretVersionParams) (int32, error)
+	GetNodePort(ctx context.Context, nodePort int32) (NodePort, error)
+	GetNodePortsByService(ctx context.Context, arg GetNodePortsByServiceParams) ([]NodePort, error)
+	GetPreviewProtection(ctx context.Context, projectID uuid.UUID) (PreviewProtection, error)
 	GetProject(ctx context.Context, id uuid.UUID) (Project, error)
 	GetProjectBranches(ctx context.Context, projectID uuid.UUID) ([]string, error)
 	GetProjectById(ctx context.Context, id uuid.UUID) (Project, error)
 	GetProjectByName(ctx context.Context, name string) (Project, error)
+	GetProjects(ctx context.Context) ([]Project, error)
 	GetProjectsByUser(ctx context.Context, userID uuid.UUID) ([]Project, error)
+	GetProjectSecrets(ctx context.Context, arg GetProjectSecretsParams) ([]byte, error)
+	GetSecretVersion(ctx context.Context, arg GetSecretVersionParams) (SecretVersion, error)
+	GetSecretVersions(ctx context.Context, arg GetSecretVersionsParams) ([]GetSecretVersionsRow, error)
 	GetService(ctx context.Context, id uuid.UUID) (Service, error)
+	GetServiceByIngress(ctx context.Context, ingress pgtype.Text) (Service, error)
 	GetServiceByName(ctx context.Context, arg GetServiceByNameParams) (Service, error)
 	GetServicesByProject(ctx context.Context, arg GetServicesByProjectParams) ([]Service, error)
 	GetServicesByUser(ctx context.Context, userID uuid.UUID) ([]GetServicesByUserRow, error)
 	GetUnusedVolumeIdentifiers(ctx context.Context, arg GetUnusedVolumeIdentifiersParams) ([]uuid.UUID, error)
+	GetUnusedVolumes(ctx context.Context, arg GetUnusedVolumesParams) ([]Volume, error)
 	GetUserByApiKey(ctx context.Context, apiKey string) (User, error)
+	GetVolume(ctx context.Context, arg GetVolumeParams) (Volume, error)
 	GetVolumeIdentifier(ctx context.Context, arg GetVolumeIdentifierParams) (uuid.UUID, error)
+	GetVolumesByBranch(ctx context.Context, arg GetVolumesByBranchParams) ([]Volume, error)
+	GetVolumeSnapshot(ctx context.Context, arg GetVolumeSnapshotParams) (GetVolumeSnapshotRow, error)
+	GetVolumeSnapshotsByProject(ctx context.Context, projectID uuid.UUID) ([]GetVolumeSnapshotsByProjectRow, error)
+	GetVolumeSnapshotsByVolume(ctx context.Context, volumeIdentifier uuid.UUID) ([]VolumeSnapshot, error)
+	HasSecretVersions(ctx context.Context, arg HasSecretVersionsParams) (bool, error)
 	IsUserInProject(ctx context.Context, arg IsUserInProjectParams) (bool, error)
+	ReleaseBranchNodePorts(ctx context.Context, arg ReleaseBranchNodePortsParams) error
+	ReleaseUnusedNodePorts(ctx context.Context, arg ReleaseUnusedNodePortsParams) error
+	ReserveNodePort(ctx context.Context, arg ReserveNodePortParams) error
 	SetServiceIngress(ctx context.Context, arg SetServiceIngressParams) error
 	SetServiceNodePorts(ctx context.Context, arg SetServiceNodePortsParams) error
+	SetVolumeDeleteAfter(ctx context.Context, arg SetVolumeDeleteAfterParams) error
+	SetVolumeSize(ctx context.Context, arg SetVolumeSizeParams) error
+	UpsertCertificate(ctx context.Context, arg UpsertCertificateParams) (int64, error)
+	UpsertDomain(ctx context.Context, arg UpsertDomainParams) (int64, error)
+	UpsertPreviewProtection(ctx context.Context, arg UpsertPreviewProtectionParams) error
+	UpsertProjectSecrets(ctx context.Context, arg UpsertProjectSecretsParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,34 +56,34 @@ func (mr *MockQuerierMockRecorder) AddUserToProject(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToProject", reflect.TypeOf((*MockQuerier)(nil).AddUserToProject), ctx, arg)
 }
 
-// CheckProjectsTableExists mocks base method.
-func (m *MockQuerier) CheckProjectsTableExists(ctx context.Context) (bool, error) {
+// CreateProject mocks base method.
+func (m *MockQuerier) CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckProjectsTableExists", ctx)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "CreateProject", ctx, arg)
+	ret0, _ := ret[0].(Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CheckProjectsTableExists indicates an expected call of CheckProjectsTableExists.
-func (mr *MockQuerierMockRecorder) CheckProjectsTableExists(ctx any) *gomock.Call {
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockQuerierMockRecorder) CreateProject(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckProjectsTableExists", reflect.TypeOf((*MockQuerier)(nil).CheckProjectsTableExists), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockQuerier)(nil).CreateProject), ctx, arg)
 }
 
-// CreateProject mocks base method.
-func (m *MockQuerier) CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error) {
+// CreateSecretVersion mocks base method.
+func (m *MockQuerier) CreateSecretVersion(ctx context.Context, arg CreateSecretVersionParams) (SecretVersion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateProject", ctx, arg)
-	ret0, _ := ret[0].(Project)
+	ret := m.ctrl.Call(m, "CreateSecretVersion", ctx, arg)
+	ret0, _ := ret[0].(SecretVersion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateProject indicates an expected call of CreateProject.
-func (mr *MockQuerierMockRecorder) CreateProject(ctx, arg any) *gomock.Call {
+// CreateSecretVersion indicates an expected call of CreateSecretVersion.
+func (mr *MockQuerierMockRecorder) CreateSecretVersion(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockQuerier)(nil).CreateProject), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecretVersion", reflect.TypeOf((*MockQuerier)(nil).CreateSecretVersion), ctx, arg)
 }
 
 // CreateService mocks base method.
@@ -115,6 +116,35 @@ func (mr *MockQuerierMockRecorder) CreateVolume(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockQuerier)(nil).CreateVolume), ctx, arg)
 }
 
+// CreateVolumeSnapshot mocks base method.
+func (m *MockQuerier) CreateVolumeSnapshot(ctx context.Context, arg CreateVolumeSnapshotParams) (VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeSnapshot", ctx, arg)
+	ret0, _ := ret[0].(VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeSnapshot indicates an expected call of CreateVolumeSnapshot.
+func (mr *MockQuerierMockRecorder) CreateVolumeSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeSnapshot", reflect.TypeOf((*MockQuerier)(nil).CreateVolumeSnapshot), ctx, arg)
+}
+
+// DeletePreviewProtection mocks base method.
+func (m *MockQuerier) DeletePreviewProtection(ctx context.Context, projectID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePreviewProtection", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePreviewProtection indicates an expected call of DeletePreviewProtection.
+func (mr *MockQuerierMockRecorder) DeletePreviewProtection(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePreviewProtection", reflect.TypeOf((*MockQuerier)(nil).DeletePreviewProtection), ctx, projectID)
+}
+
 // DeleteProject mocks base method.
 func (m *MockQuerier) DeleteProject(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -129,6 +159,48 @@ func (mr *MockQuerierMockRecorder) DeleteProject(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockQuerier)(nil).DeleteProject), ctx, id)
 }
 
+// DeleteProjectSecrets mocks base method.
+func (m *MockQuerier) DeleteProjectSecrets(ctx context.Context, arg DeleteProjectSecretsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectSecrets", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectSecrets indicates an expected call of DeleteProjectSecrets.
+func (mr *MockQuerierMockRecorder) DeleteProjectSecrets(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectSecrets", reflect.TypeOf((*MockQuerier)(nil).DeleteProjectSecrets), ctx, arg)
+}
+
+// DeleteSecretVersion mocks base method.
+func (m *MockQuerier) DeleteSecretVersion(ctx context.Context, arg DeleteSecretVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecretVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecretVersion indicates an expected call of DeleteSecretVersion.
+func (mr *MockQuerierMockRecorder) DeleteSecretVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretVersion", reflect.TypeOf((*MockQuerier)(nil).DeleteSecretVersion), ctx, arg)
+}
+
+// DeleteSecretVersionsByBranch mocks base method.
+func (m *MockQuerier) DeleteSecretVersionsByBranch(ctx context.Context, arg DeleteSecretVersionsByBranchParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecretVersionsByBranch", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecretVersionsByBranch indicates an expected call of DeleteSecretVersionsByBranch.
+func (mr *MockQuerierMockRecorder) DeleteSecretVersionsByBranch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretVersionsByBranch", reflect.TypeOf((*MockQuerier)(nil).DeleteSecretVersionsByBranch), ctx, arg)
+}
+
 // DeleteServiceById mocks base method.
 func (m *MockQuerier) DeleteServiceById(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -157,6 +229,20 @@ func (mr *MockQuerierMockRecorder) DeleteServiceByName(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceByName", reflect.TypeOf((*MockQuerier)(nil).DeleteServiceByName), ctx, arg)
 }
 
+// DeleteUnusedDomains mocks base method.
+func (m *MockQuerier) DeleteUnusedDomains(ctx context.Context, arg DeleteUnusedDomainsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnusedDomains", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnusedDomains indicates an expected call of DeleteUnusedDomains.
+func (mr *MockQuerierMockRecorder) DeleteUnusedDomains(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnusedDomains", reflect.TypeOf((*MockQuerier)(nil).DeleteUnusedDomains), ctx, arg)
+}
+
 // DeleteUnusedVolumes mocks base method.
 func (m *MockQuerier) DeleteUnusedVolumes(ctx context.Context, arg DeleteUnusedVolumesParams) error {
 	m.ctrl.T.Helper()
@@ -171,6 +257,35 @@ func (mr *MockQuerierMockRecorder) DeleteUnusedVolumes(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnusedVolumes", reflect.TypeOf((*MockQuerier)(nil).DeleteUnusedVolumes), ctx, arg)
 }
 
+// DeleteVolume mocks base method.
+func (m *MockQuerier) DeleteVolume(ctx context.Context, identifier uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", ctx, identifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockQuerierMockRecorder) DeleteVolume(ctx, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockQuerier)(nil).DeleteVolume), ctx, identifier)
+}
+
+// GetAllocatedNodePorts mocks base method.
+func (m *MockQuerier) GetAllocatedNodePorts(ctx context.Context) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocatedNodePorts", ctx)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllocatedNodePorts indicates an expected call of GetAllocatedNodePorts.
+func (mr *MockQuerierMockRecorder) GetAllocatedNodePorts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocatedNodePorts", reflect.TypeOf((*MockQuerier)(nil).GetAllocatedNodePorts), ctx)
+}
+
 // GetApiKeyExistance mocks base method.
 func (m *MockQuerier) GetApiKeyExistance(ctx context.Context, apiKey string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +301,126 @@ func (mr *MockQuerierMockRecorder) GetApiKeyExistance(ctx, apiKey any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiKeyExistance", reflect.TypeOf((*MockQuerier)(nil).GetApiKeyExistance), ctx, apiKey)
 }
 
+// GetCertificate mocks base method.
+func (m *MockQuerier) GetCertificate(ctx context.Context, domain string) (Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificate", ctx, domain)
+	ret0, _ := ret[0].(Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificate indicates an expected call of GetCertificate.
+func (mr *MockQuerierMockRecorder) GetCertificate(ctx, domain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificate", reflect.TypeOf((*MockQuerier)(nil).GetCertificate), ctx, domain)
+}
+
+// GetCertificatesByProject mocks base method.
+func (m *MockQuerier) GetCertificatesByProject(ctx context.Context, projectID uuid.UUID) ([]Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificatesByProject", ctx, projectID)
+	ret0, _ := ret[0].([]Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificatesByProject indicates an expected call of GetCertificatesByProject.
+func (mr *MockQuerierMockRecorder) GetCertificatesByProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificatesByProject", reflect.TypeOf((*MockQuerier)(nil).GetCertificatesByProject), ctx, projectID)
+}
+
+// GetDomain mocks base method.
+func (m *MockQuerier) GetDomain(ctx context.Context, domain string) (Domain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomain", ctx, domain)
+	ret0, _ := ret[0].(Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomain indicates an expected call of GetDomain.
+func (mr *MockQuerierMockRecorder) GetDomain(ctx, domain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomain", reflect.TypeOf((*MockQuerier)(nil).GetDomain), ctx, domain)
+}
+
+// GetExpiredVolumes mocks base method.
+func (m *MockQuerier) GetExpiredVolumes(ctx context.Context) ([]Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredVolumes", ctx)
+	ret0, _ := ret[0].([]Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredVolumes indicates an expected call of GetExpiredVolumes.
+func (mr *MockQuerierMockRecorder) GetExpiredVolumes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredVolumes", reflect.TypeOf((*MockQuerier)(nil).GetExpiredVolumes), ctx)
+}
+
+// GetLatestSecretVersion mocks base method.
+func (m *MockQuerier) GetLatestSecretVersion(ctx context.Context, arg GetLatestSecretVersionParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestSecretVersion", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestSecretVersion indicates an expected call of GetLatestSecretVersion.
+func (mr *MockQuerierMockRecorder) GetLatestSecretVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestSecretVersion", reflect.TypeOf((*MockQuerier)(nil).GetLatestSecretVersion), ctx, arg)
+}
+
+// GetNodePort mocks base method.
+func (m *MockQuerier) GetNodePort(ctx context.Context, nodePort int32) (NodePort, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePort", ctx, nodePort)
+	ret0, _ := ret[0].(NodePort)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodePort indicates an expected call of GetNodePort.
+func (mr *MockQuerierMockRecorder) GetNodePort(ctx, nodePort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePort", reflect.TypeOf((*MockQuerier)(nil).GetNodePort), ctx, nodePort)
+}
+
+// GetNodePortsByService mocks base method.
+func (m *MockQuerier) GetNodePortsByService(ctx context.Context, arg GetNodePortsByServiceParams) ([]NodePort, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePortsByService", ctx, arg)
+	ret0, _ := ret[0].([]NodePort)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodePortsByService indicates an expected call of GetNodePortsByService.
+func (mr *MockQuerierMockRecorder) GetNodePortsByService(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePortsByService", reflect.TypeOf((*MockQuerier)(nil).GetNodePortsByService), ctx, arg)
+}
+
+// GetPreviewProtection mocks base method.
+func (m *MockQuerier) GetPreviewProtection(ctx context.Context, projectID uuid.UUID) (PreviewProtection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviewProtection", ctx, projectID)
+	ret0, _ := ret[0].(PreviewProtection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviewProtection indicates an expected call of GetPreviewProtection.
+func (mr *MockQuerierMockRecorder) GetPreviewProtection(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewProtection", reflect.TypeOf((*MockQuerier)(nil).GetPreviewProtection), ctx, projectID)
+}
+
 // GetProject mocks base method.
 func (m *MockQuerier) GetProject(ctx context.Context, id uuid.UUID) (Project, error) {
 	m.ctrl.T.Helper()
@@ -246,6 +481,36 @@ func (mr *MockQuerierMockRecorder) GetProjectByName(ctx, name any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByName", reflect.TypeOf((*MockQuerier)(nil).GetProjectByName), ctx, name)
 }
 
+// GetProjectSecrets mocks base method.
+func (m *MockQuerier) GetProjectSecrets(ctx context.Context, arg GetProjectSecretsParams) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectSecrets", ctx, arg)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectSecrets indicates an expected call of GetProjectSecrets.
+func (mr *MockQuerierMockRecorder) GetProjectSecrets(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectSecrets", reflect.TypeOf((*MockQuerier)(nil).GetProjectSecrets), ctx, arg)
+}
+
+// GetProjects mocks base method.
+func (m *MockQuerier) GetProjects(ctx context.Context) ([]Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjects", ctx)
+	ret0, _ := ret[0].([]Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjects indicates an expected call of GetProjects.
+func (mr *MockQuerierMockRecorder) GetProjects(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockQuerier)(nil).GetProjects), ctx)
+}
+
 // GetProjectsByUser mocks base method.
 func (m *MockQuerier) GetProjectsByUser(ctx context.Context, userID uuid.UUID) ([]Project, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +526,36 @@ func (mr *MockQuerierMockRecorder) GetProjectsByUser(ctx, userID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectsByUser", reflect.TypeOf((*MockQuerier)(nil).GetProjectsByUser), ctx, userID)
 }
 
+// GetSecretVersion mocks base method.
+func (m *MockQuerier) GetSecretVersion(ctx context.Context, arg GetSecretVersionParams) (SecretVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretVersion", ctx, arg)
+	ret0, _ := ret[0].(SecretVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretVersion indicates an expected call of GetSecretVersion.
+func (mr *MockQuerierMockRecorder) GetSecretVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretVersion", reflect.TypeOf((*MockQuerier)(nil).GetSecretVersion), ctx, arg)
+}
+
+// GetSecretVersions mocks base method.
+func (m *MockQuerier) GetSecretVersions(ctx context.Context, arg GetSecretVersionsParams) ([]GetSecretVersionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretVersions", ctx, arg)
+	ret0, _ := ret[0].([]GetSecretVersionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretVersions indicates an expected call of GetSecretVersions.
+func (mr *MockQuerierMockRecorder) GetSecretVersions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretVersions", reflect.TypeOf((*MockQuerier)(nil).GetSecretVersions), ctx, arg)
+}
+
 // GetService mocks base method.
 func (m *MockQuerier) GetService(ctx context.Context, id uuid.UUID) (Service, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +571,21 @@ func (mr *MockQuerierMockRecorder) GetService(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockQuerier)(nil).GetService), ctx, id)
 }
 
+// GetServiceByIngress mocks base method.
+func (m *MockQuerier) GetServiceByIngress(ctx context.Context, ingress pgtype.Text) (Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceByIngress", ctx, ingress)
+	ret0, _ := ret[0].(Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceByIngress indicates an expected call of GetServiceByIngress.
+func (mr *MockQuerierMockRecorder) GetServiceByIngress(ctx, ingress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceByIngress", reflect.TypeOf((*MockQuerier)(nil).GetServiceByIngress), ctx, ingress)
+}
+
 // GetServiceByName mocks base method.
 func (m *MockQuerier) GetServiceByName(ctx context.Context, arg GetServiceByNameParams) (Service, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +646,21 @@ func (mr *MockQuerierMockRecorder) GetUnusedVolumeIdentifiers(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnusedVolumeIdentifiers", reflect.TypeOf((*MockQuerier)(nil).GetUnusedVolumeIdentifiers), ctx, arg)
 }
 
+// GetUnusedVolumes mocks base method.
+func (m *MockQuerier) GetUnusedVolumes(ctx context.Context, arg GetUnusedVolumesParams) ([]Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnusedVolumes", ctx, arg)
+	ret0, _ := ret[0].([]Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnusedVolumes indicates an expected call of GetUnusedVolumes.
+func (mr *MockQuerierMockRecorder) GetUnusedVolumes(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnusedVolumes", reflect.TypeOf((*MockQuerier)(nil).GetUnusedVolumes), ctx, arg)
+}
+
 // GetUserByApiKey mocks base method.
 func (m *MockQuerier) GetUserByApiKey(ctx context.Context, apiKey string) (User, error) {
 	m.ctrl.T.Helper()
@@ -351,6 +676,21 @@ func (mr *MockQuerierMockRecorder) GetUserByApiKey(ctx, apiKey any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByApiKey", reflect.TypeOf((*MockQuerier)(nil).GetUserByApiKey), ctx, apiKey)
 }
 
+// GetVolume mocks base method.
+func (m *MockQuerier) GetVolume(ctx context.Context, arg GetVolumeParams) (Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", ctx, arg)
+	ret0, _ := ret[0].(Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockQuerierMockRecorder) GetVolume(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockQuerier)(nil).GetVolume), ctx, arg)
+}
+
 // GetVolumeIdentifier mocks base method.
 func (m *MockQuerier) GetVolumeIdentifier(ctx context.Context, arg GetVolumeIdentifierParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -366,6 +706,81 @@ func (mr *MockQuerierMockRecorder) GetVolumeIdentifier(ctx, arg any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeIdentifier", reflect.TypeOf((*MockQuerier)(nil).GetVolumeIdentifier), ctx, arg)
 }
 
+// GetVolumeSnapshot mocks base method.
+func (m *MockQuerier) GetVolumeSnapshot(ctx context.Context, arg GetVolumeSnapshotParams) (GetVolumeSnapshotRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeSnapshot", ctx, arg)
+	ret0, _ := ret[0].(GetVolumeSnapshotRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeSnapshot indicates an expected call of GetVolumeSnapshot.
+func (mr *MockQuerierMockRecorder) GetVolumeSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshot", reflect.TypeOf((*MockQuerier)(nil).GetVolumeSnapshot), ctx, arg)
+}
+
+// GetVolumeSnapshotsByProject mocks base method.
+func (m *MockQuerier) GetVolumeSnapshotsByProject(ctx context.Context, projectID uuid.UUID) ([]GetVolumeSnapshotsByProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeSnapshotsByProject", ctx, projectID)
+	ret0, _ := ret[0].([]GetVolumeSnapshotsByProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeSnapshotsByProject indicates an expected call of GetVolumeSnapshotsByProject.
+func (mr *MockQuerierMockRecorder) GetVolumeSnapshotsByProject(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshotsByProject", reflect.TypeOf((*MockQuerier)(nil).GetVolumeSnapshotsByProject), ctx, projectID)
+}
+
+// GetVolumeSnapshotsByVolume mocks base method.
+func (m *MockQuerier) GetVolumeSnapshotsByVolume(ctx context.Context, volumeIdentifier uuid.UUID) ([]VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeSnapshotsByVolume", ctx, volumeIdentifier)
+	ret0, _ := ret[0].([]VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeSnapshotsByVolume indicates an expected call of GetVolumeSnapshotsByVolume.
+func (mr *MockQuerierMockRecorder) GetVolumeSnapshotsByVolume(ctx, volumeIdentifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshotsByVolume", reflect.TypeOf((*MockQuerier)(nil).GetVolumeSnapshotsByVolume), ctx, volumeIdentifier)
+}
+
+// GetVolumesByBranch mocks base method.
+func (m *MockQuerier) GetVolumesByBranch(ctx context.Context, arg GetVolumesByBranchParams) ([]Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumesByBranch", ctx, arg)
+	ret0, _ := ret[0].([]Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumesByBranch indicates an expected call of GetVolumesByBranch.
+func (mr *MockQuerierMockRecorder) GetVolumesByBranch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesByBranch", reflect.TypeOf((*MockQuerier)(nil).GetVolumesByBranch), ctx, arg)
+}
+
+// HasSecretVersions mocks base method.
+func (m *MockQuerier) HasSecretVersions(ctx context.Context, arg HasSecretVersionsParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSecretVersions", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasSecretVersions indicates an expected call of HasSecretVersions.
+func (mr *MockQuerierMockRecorder) HasSecretVersions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSecretVersions", reflect.TypeOf((*MockQuerier)(nil).HasSecretVersions), ctx, arg)
+}
+
 // IsUserInProject mocks base method.
 func (m *MockQuerier) IsUserInProject(ctx context.Context, arg IsUserInProjectParams) (bool, error) {
 	m.ctrl.T.Helper()
@@ -381,6 +796,48 @@ func (mr *MockQuerierMockRecorder) IsUserInProject(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserInProject", reflect.TypeOf((*MockQuerier)(nil).IsUserInProject), ctx, arg)
 }
 
+// ReleaseBranchNodePorts mocks base method.
+func (m *MockQuerier) ReleaseBranchNodePorts(ctx context.Context, arg ReleaseBranchNodePortsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseBranchNodePorts", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseBranchNodePorts indicates an expected call of ReleaseBranchNodePorts.
+func (mr *MockQuerierMockRecorder) ReleaseBranchNodePorts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBranchNodePorts", reflect.TypeOf((*MockQuerier)(nil).ReleaseBranchNodePorts), ctx, arg)
+}
+
+// ReleaseUnusedNodePorts mocks base method.
+func (m *MockQuerier) ReleaseUnusedNodePorts(ctx context.Context, arg ReleaseUnusedNodePortsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseUnusedNodePorts", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseUnusedNodePorts indicates an expected call of ReleaseUnusedNodePorts.
+func (mr *MockQuerierMockRecorder) ReleaseUnusedNodePorts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseUnusedNodePorts", reflect.TypeOf((*MockQuerier)(nil).ReleaseUnusedNodePorts), ctx, arg)
+}
+
+// ReserveNodePort mocks base method.
+func (m *MockQuerier) ReserveNodePort(ctx context.Context, arg ReserveNodePortParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveNodePort", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReserveNodePort indicates an expected call of ReserveNodePort.
+func (mr *MockQuerierMockRecorder) ReserveNodePort(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveNodePort", reflect.TypeOf((*MockQuerier)(nil).ReserveNodePort), ctx, arg)
+}
+
 // SetServiceIngress mocks base method.
 func (m *MockQuerier) SetServiceIngress(ctx context.Context, arg SetServiceIngressParams) error {
 	m.ctrl.T.Helper()
@@ -408,3 +865,89 @@ func (mr *MockQuerierMockRecorder) SetServiceNodePorts(ctx, arg any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServiceNodePorts", reflect.TypeOf((*MockQuerier)(nil).SetServiceNodePorts), ctx, arg)
 }
+
+// SetVolumeDeleteAfter mocks base method.
+func (m *MockQuerier) SetVolumeDeleteAfter(ctx context.Context, arg SetVolumeDeleteAfterParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVolumeDeleteAfter", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVolumeDeleteAfter indicates an expected call of SetVolumeDeleteAfter.
+func (mr *MockQuerierMockRecorder) SetVolumeDeleteAfter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVolumeDeleteAfter", reflect.TypeOf((*MockQuerier)(nil).SetVolumeDeleteAfter), ctx, arg)
+}
+
+// SetVolumeSize mocks base method.
+func (m *MockQuerier) SetVolumeSize(ctx context.Context, arg SetVolumeSizeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVolumeSize", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVolumeSize indicates an expected call of SetVolumeSize.
+func (mr *MockQuerierMockRecorder) SetVolumeSize(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVolumeSize", reflect.TypeOf((*MockQuerier)(nil).SetVolumeSize), ctx, arg)
+}
+
+// UpsertCertificate mocks base method.
+func (m *MockQuerier) UpsertCertificate(ctx context.Context, arg UpsertCertificateParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCertificate", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertCertificate indicates an expected call of UpsertCertificate.
+func (mr *MockQuerierMockRecorder) UpsertCertificate(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCertificate", reflect.TypeOf((*MockQuerier)(nil).UpsertCertificate), ctx, arg)
+}
+
+// UpsertDomain mocks base method.
+func (m *MockQuerier) UpsertDomain(ctx context.Context, arg UpsertDomainParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDomain", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertDomain indicates an expected call of UpsertDomain.
+func (mr *MockQuerierMockRecorder) UpsertDomain(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDomain", reflect.TypeOf((*MockQuerier)(nil).UpsertDomain), ctx, arg)
+}
+
+// UpsertPreviewProtection mocks base method.
+func (m *MockQuerier) UpsertPreviewProtection(ctx context.Context, arg UpsertPreviewProtectionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPreviewProtection", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertPreviewProtection indicates an expected call of UpsertPreviewProtection.
+func (mr *MockQuerierMockRecorder) UpsertPreviewProtection(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPreviewProtection", reflect.TypeOf((*MockQuerier)(nil).UpsertPreviewProtection), ctx, arg)
+}
+
+// UpsertProjectSecrets mocks base method.
+func (m *MockQuerier) UpsertProjectSecrets(ctx context.Context, arg UpsertProjectSecretsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProjectSecrets", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertProjectSecrets indicates an expected call of UpsertProjectSecrets.
+func (mr *MockQuerierMockRecorder) UpsertProjectSecrets(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProjectSecrets", reflect.TypeOf((*MockQuerier)(nil).UpsertProjectSecrets), ctx, arg)
+}
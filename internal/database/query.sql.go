@@ -27,25 +27,6 @@ func (q *Queries) AddUserToProject(ctx context.Context, arg AddUserToProjectPara
 	return err
 }
 
-const checkProjectsTableExists = `-- name: CheckProjectsTableExists :one
-SELECT
-  EXISTS (
-    SELECT
-      1
-    FROM
-      information_schema.tables
-    WHERE
-      table_schema = 'public'
-      AND table_name = 'projects')
-`
-
-func (q *Queries) CheckProjectsTableExists(ctx context.Context) (bool, error) {
-	row := q.db.QueryRow(ctx, checkProjectsTableExists)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
-}
-
 const createProject = `-- name: CreateProject :one
 INSERT INTO projects (id, name)
   VALUES ($1, $2)
@@ -65,6 +46,50 @@ func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (P
 	return i, err
 }
 
+const createSecretVersion = `-- name: CreateSecretVersion :one
+INSERT INTO secret_versions (project_id, project_branch, version, data, user_id, added, removed, changed)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+  project_id, project_branch, version, data, user_id, added, removed, changed, created_at
+`
+
+type CreateSecretVersionParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	Version       int32
+	Data          []byte
+	UserID        pgtype.UUID
+	Added         []string
+	Removed       []string
+	Changed       []string
+}
+
+func (q *Queries) CreateSecretVersion(ctx context.Context, arg CreateSecretVersionParams) (SecretVersion, error) {
+	row := q.db.QueryRow(ctx, createSecretVersion,
+		arg.ProjectID,
+		arg.ProjectBranch,
+		arg.Version,
+		arg.Data,
+		arg.UserID,
+		arg.Added,
+		arg.Removed,
+		arg.Changed,
+	)
+	var i SecretVersion
+	err := row.Scan(
+		&i.ProjectID,
+		&i.ProjectBranch,
+		&i.Version,
+		&i.Data,
+		&i.UserID,
+		&i.Added,
+		&i.Removed,
+		&i.Changed,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createService = `-- name: CreateService :one
 INSERT INTO services (id, project_id, project_branch, service_name, node_ports, ingress)
   VALUES ($1, $2, $3, $4, $5, $6)
@@ -103,10 +128,10 @@ func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (S
 }
 
 const createVolume = `-- name: CreateVolume :one
-INSERT INTO volumes (identifier, volume_name, project_id, project_branch, size)
-  VALUES ($1, $2, $3, $4, $5)
+INSERT INTO volumes (identifier, volume_name, project_id, project_branch, size, storage)
+  VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING
-  identifier, volume_name, project_id, project_branch, size
+  identifier, volume_name, project_id, project_branch, size, storage, delete_after
 `
 
 type CreateVolumeParams struct {
@@ -115,6 +140,7 @@ type CreateVolumeParams struct {
 	ProjectID     uuid.UUID
 	ProjectBranch string
 	Size          int32
+	Storage       string
 }
 
 func (q *Queries) CreateVolume(ctx context.Context, arg CreateVolumeParams) (Volume, error) {
@@ -124,6 +150,7 @@ func (q *Queries) CreateVolume(ctx context.Context, arg CreateVolumeParams) (Vol
 		arg.ProjectID,
 		arg.ProjectBranch,
 		arg.Size,
+		arg.Storage,
 	)
 	var i Volume
 	err := row.Scan(
@@ -132,10 +159,57 @@ func (q *Queries) CreateVolume(ctx context.Context, arg CreateVolumeParams) (Vol
 		&i.ProjectID,
 		&i.ProjectBranch,
 		&i.Size,
+		&i.Storage,
+		&i.DeleteAfter,
 	)
 	return i, err
 }
 
+const createVolumeSnapshot = `-- name: CreateVolumeSnapshot :one
+INSERT INTO volume_snapshots (id, volume_identifier, project_id, method, size)
+  VALUES ($1, $2, $3, $4, $5)
+RETURNING
+  id, volume_identifier, project_id, method, size, created_at
+`
+
+type CreateVolumeSnapshotParams struct {
+	ID               uuid.UUID
+	VolumeIdentifier uuid.UUID
+	ProjectID        uuid.UUID
+	Method           string
+	Size             int32
+}
+
+func (q *Queries) CreateVolumeSnapshot(ctx context.Context, arg CreateVolumeSnapshotParams) (VolumeSnapshot, error) {
+	row := q.db.QueryRow(ctx, createVolumeSnapshot,
+		arg.ID,
+		arg.VolumeIdentifier,
+		arg.ProjectID,
+		arg.Method,
+		arg.Size,
+	)
+	var i VolumeSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeIdentifier,
+		&i.ProjectID,
+		&i.Method,
+		&i.Size,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deletePreviewProtection = `-- name: DeletePreviewProtection :exec
+DELETE FROM preview_protections
+WHERE project_id = $1
+`
+
+func (q *Queries) DeletePreviewProtection(ctx context.Context, projectID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePreviewProtection, projectID)
+	return err
+}
+
 const deleteProject = `-- name: DeleteProject :exec
 DELETE FROM projects
 WHERE id = $1
@@ -146,6 +220,62 @@ func (q *Queries) DeleteProject(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteProjectSecrets = `-- name: DeleteProjectSecrets :exec
+DELETE FROM project_secrets
+WHERE project_branch = $1
+  AND project_id = (
+    SELECT
+      id
+    FROM
+      projects
+    WHERE
+      name = $2)
+`
+
+type DeleteProjectSecretsParams struct {
+	ProjectBranch string
+	ProjectName   string
+}
+
+func (q *Queries) DeleteProjectSecrets(ctx context.Context, arg DeleteProjectSecretsParams) error {
+	_, err := q.db.Exec(ctx, deleteProjectSecrets, arg.ProjectBranch, arg.ProjectName)
+	return err
+}
+
+const deleteSecretVersion = `-- name: DeleteSecretVersion :exec
+DELETE FROM secret_versions
+WHERE project_id = $1
+  AND project_branch = $2
+  AND version = $3
+`
+
+type DeleteSecretVersionParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	Version       int32
+}
+
+func (q *Queries) DeleteSecretVersion(ctx context.Context, arg DeleteSecretVersionParams) error {
+	_, err := q.db.Exec(ctx, deleteSecretVersion, arg.ProjectID, arg.ProjectBranch, arg.Version)
+	return err
+}
+
+const deleteSecretVersionsByBranch = `-- name: DeleteSecretVersionsByBranch :exec
+DELETE FROM secret_versions
+WHERE project_id = $1
+  AND project_branch = $2
+`
+
+type DeleteSecretVersionsByBranchParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+}
+
+func (q *Queries) DeleteSecretVersionsByBranch(ctx context.Context, arg DeleteSecretVersionsByBranchParams) error {
+	_, err := q.db.Exec(ctx, deleteSecretVersionsByBranch, arg.ProjectID, arg.ProjectBranch)
+	return err
+}
+
 const deleteServiceById = `-- name: DeleteServiceById :exec
 DELETE FROM services
 WHERE id = $1
@@ -174,6 +304,22 @@ func (q *Queries) DeleteServiceByName(ctx context.Context, arg DeleteServiceByNa
 	return err
 }
 
+const deleteUnusedDomains = `-- name: DeleteUnusedDomains :exec
+DELETE FROM domains
+WHERE project_id = $1
+  AND NOT domain = ANY ($2::text[])
+`
+
+type DeleteUnusedDomainsParams struct {
+	ProjectID   uuid.UUID
+	KeepDomains []string
+}
+
+func (q *Queries) DeleteUnusedDomains(ctx context.Context, arg DeleteUnusedDomainsParams) error {
+	_, err := q.db.Exec(ctx, deleteUnusedDomains, arg.ProjectID, arg.KeepDomains)
+	return err
+}
+
 const deleteUnusedVolumes = `-- name: DeleteUnusedVolumes :exec
 DELETE FROM volumes
 WHERE project_id = $1
@@ -192,6 +338,45 @@ func (q *Queries) DeleteUnusedVolumes(ctx context.Context, arg DeleteUnusedVolum
 	return err
 }
 
+const deleteVolume = `-- name: DeleteVolume :exec
+DELETE FROM volumes
+WHERE identifier = $1
+`
+
+func (q *Queries) DeleteVolume(ctx context.Context, identifier uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteVolume, identifier)
+	return err
+}
+
+const getAllocatedNodePorts = `-- name: GetAllocatedNodePorts :many
+SELECT
+  node_port
+FROM
+  node_ports
+ORDER BY
+  node_port
+`
+
+func (q *Queries) GetAllocatedNodePorts(ctx context.Context) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getAllocatedNodePorts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var node_port int32
+		if err := rows.Scan(&node_port); err != nil {
+			return nil, err
+		}
+		items = append(items, node_port)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getApiKeyExistance = `-- name: GetApiKeyExistance :one
 SELECT
   EXISTS (
@@ -210,52 +395,57 @@ func (q *Queries) GetApiKeyExistance(ctx context.Context, apiKey string) (bool,
 	return exists, err
 }
 
-const getProject = `-- name: GetProject :one
+const getCertificate = `-- name: GetCertificate :one
 SELECT
-  id, name
+  domain, project_id, secret_name, not_after
 FROM
-  projects
+  certificates
 WHERE
-  id = $1
+  domain = $1
 LIMIT 1
 `
 
-func (q *Queries) GetProject(ctx context.Context, id uuid.UUID) (Project, error) {
-	row := q.db.QueryRow(ctx, getProject, id)
-	var i Project
-	err := row.Scan(&i.ID, &i.Name)
+func (q *Queries) GetCertificate(ctx context.Context, domain string) (Certificate, error) {
+	row := q.db.QueryRow(ctx, getCertificate, domain)
+	var i Certificate
+	err := row.Scan(
+		&i.Domain,
+		&i.ProjectID,
+		&i.SecretName,
+		&i.NotAfter,
+	)
 	return i, err
 }
 
-const getProjectBranches = `-- name: GetProjectBranches :many
-SELECT
-  project_branch
-FROM
-  services s
-WHERE
-  s.project_id = $1
-UNION
+const getCertificatesByProject = `-- name: GetCertificatesByProject :many
 SELECT
-  project_branch
+  domain, project_id, secret_name, not_after
 FROM
-  volumes v
+  certificates
 WHERE
-  v.project_id = $1
+  project_id = $1
+ORDER BY
+  domain
 `
 
-func (q *Queries) GetProjectBranches(ctx context.Context, projectID uuid.UUID) ([]string, error) {
-	rows, err := q.db.Query(ctx, getProjectBranches, projectID)
+func (q *Queries) GetCertificatesByProject(ctx context.Context, projectID uuid.UUID) ([]Certificate, error) {
+	rows, err := q.db.Query(ctx, getCertificatesByProject, projectID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []Certificate
 	for rows.Next() {
-		var project_branch string
-		if err := rows.Scan(&project_branch); err != nil {
+		var i Certificate
+		if err := rows.Scan(
+			&i.Domain,
+			&i.ProjectID,
+			&i.SecretName,
+			&i.NotAfter,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, project_branch)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -263,62 +453,54 @@ func (q *Queries) GetProjectBranches(ctx context.Context, projectID uuid.UUID) (
 	return items, nil
 }
 
-const getProjectById = `-- name: GetProjectById :one
-SELECT
-  id,
-  name
-FROM
-  projects
-WHERE
-  id = $1
-`
-
-func (q *Queries) GetProjectById(ctx context.Context, id uuid.UUID) (Project, error) {
-	row := q.db.QueryRow(ctx, getProjectById, id)
-	var i Project
-	err := row.Scan(&i.ID, &i.Name)
-	return i, err
-}
-
-const getProjectByName = `-- name: GetProjectByName :one
+const getDomain = `-- name: GetDomain :one
 SELECT
-  id, name
+  domain, project_id, service_name
 FROM
-  projects
+  domains
 WHERE
-  name = $1
+  domain = $1
 LIMIT 1
 `
 
-func (q *Queries) GetProjectByName(ctx context.Context, name string) (Project, error) {
-	row := q.db.QueryRow(ctx, getProjectByName, name)
-	var i Project
-	err := row.Scan(&i.ID, &i.Name)
+func (q *Queries) GetDomain(ctx context.Context, domain string) (Domain, error) {
+	row := q.db.QueryRow(ctx, getDomain, domain)
+	var i Domain
+	err := row.Scan(&i.Domain, &i.ProjectID, &i.ServiceName)
 	return i, err
 }
 
-const getProjectsByUser = `-- name: GetProjectsByUser :many
+const getExpiredVolumes = `-- name: GetExpiredVolumes :many
 SELECT
-  p.id, p.name
+  identifier, volume_name, project_id, project_branch, size, storage, delete_after
 FROM
-  projects p
-  JOIN user_projects up ON p.id = up.project_id
+  volumes
 WHERE
-  up.user_id = $1
+  delete_after <= now()
 ORDER BY
-  p.name
+  project_id,
+  project_branch,
+  volume_name
 `
 
-func (q *Queries) GetProjectsByUser(ctx context.Context, userID uuid.UUID) ([]Project, error) {
-	rows, err := q.db.Query(ctx, getProjectsByUser, userID)
+func (q *Queries) GetExpiredVolumes(ctx context.Context) ([]Volume, error) {
+	rows, err := q.db.Query(ctx, getExpiredVolumes)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Project
+	var items []Volume
 	for rows.Next() {
-		var i Project
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		var i Volume
+		if err := rows.Scan(
+			&i.Identifier,
+			&i.VolumeName,
+			&i.ProjectID,
+			&i.ProjectBranch,
+			&i.Size,
+			&i.Storage,
+			&i.DeleteAfter,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -329,95 +511,87 @@ func (q *Queries) GetProjectsByUser(ctx context.Context, userID uuid.UUID) ([]Pr
 	return items, nil
 }
 
-const getService = `-- name: GetService :one
+const getLatestSecretVersion = `-- name: GetLatestSecretVersion :one
 SELECT
-  id, project_id, project_branch, service_name, node_ports, ingress
+  COALESCE(MAX(version), 0)::integer
 FROM
-  services
+  secret_versions
 WHERE
-  id = $1
-LIMIT 1
+  project_id = $1
+  AND project_branch = $2
 `
 
-func (q *Queries) GetService(ctx context.Context, id uuid.UUID) (Service, error) {
-	row := q.db.QueryRow(ctx, getService, id)
-	var i Service
-	err := row.Scan(
-		&i.ID,
-		&i.ProjectID,
-		&i.ProjectBranch,
-		&i.ServiceName,
-		&i.NodePorts,
-		&i.Ingress,
-	)
-	return i, err
+type GetLatestSecretVersionParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
 }
 
-const getServiceByName = `-- name: GetServiceByName :one
+func (q *Queries) GetLatestSecretVersion(ctx context.Context, arg GetLatestSecretVersionParams) (int32, error) {
+	row := q.db.QueryRow(ctx, getLatestSecretVersion, arg.ProjectID, arg.ProjectBranch)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getNodePort = `-- name: GetNodePort :one
 SELECT
-  id, project_id, project_branch, service_name, node_ports, ingress
+  node_port, project_id, project_branch, service_name, port, protocol
 FROM
-  services
+  node_ports
 WHERE
-  service_name = $1
-  AND project_id = $2
-  AND project_branch = $3
+  node_port = $1
 LIMIT 1
 `
 
-type GetServiceByNameParams struct {
-	ServiceName   string
-	ProjectID     uuid.UUID
-	ProjectBranch string
-}
-
-func (q *Queries) GetServiceByName(ctx context.Context, arg GetServiceByNameParams) (Service, error) {
-	row := q.db.QueryRow(ctx, getServiceByName, arg.ServiceName, arg.ProjectID, arg.ProjectBranch)
-	var i Service
+func (q *Queries) GetNodePort(ctx context.Context, nodePort int32) (NodePort, error) {
+	row := q.db.QueryRow(ctx, getNodePort, nodePort)
+	var i NodePort
 	err := row.Scan(
-		&i.ID,
+		&i.NodePort,
 		&i.ProjectID,
 		&i.ProjectBranch,
 		&i.ServiceName,
-		&i.NodePorts,
-		&i.Ingress,
+		&i.Port,
+		&i.Protocol,
 	)
 	return i, err
 }
 
-const getServicesByProject = `-- name: GetServicesByProject :many
+const getNodePortsByService = `-- name: GetNodePortsByService :many
 SELECT
-  id, project_id, project_branch, service_name, node_ports, ingress
+  node_port, project_id, project_branch, service_name, port, protocol
 FROM
-  services
+  node_ports
 WHERE
   project_id = $1
   AND project_branch = $2
+  AND service_name = $3
 ORDER BY
-  service_name
+  port
 `
 
-type GetServicesByProjectParams struct {
+type GetNodePortsByServiceParams struct {
 	ProjectID     uuid.UUID
 	ProjectBranch string
+	ServiceName   string
 }
 
-func (q *Queries) GetServicesByProject(ctx context.Context, arg GetServicesByProjectParams) ([]Service, error) {
-	rows, err := q.db.Query(ctx, getServicesByProject, arg.ProjectID, arg.ProjectBranch)
+func (q *Queries) GetNodePortsByService(ctx context.Context, arg GetNodePortsByServiceParams) ([]NodePort, error) {
+	rows, err := q.db.Query(ctx, getNodePortsByService, arg.ProjectID, arg.ProjectBranch, arg.ServiceName)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Service
+	var items []NodePort
 	for rows.Next() {
-		var i Service
+		var i NodePort
 		if err := rows.Scan(
-			&i.ID,
+			&i.NodePort,
 			&i.ProjectID,
 			&i.ProjectBranch,
 			&i.ServiceName,
-			&i.NodePorts,
-			&i.Ingress,
+			&i.Port,
+			&i.Protocol,
 		); err != nil {
 			return nil, err
 		}
@@ -429,48 +603,664 @@ func (q *Queries) GetServicesByProject(ctx context.Context, arg GetServicesByPro
 	return items, nil
 }
 
-const getServicesByUser = `-- name: GetServicesByUser :many
+const getPreviewProtection = `-- name: GetPreviewProtection :one
 SELECT
-  s.id, s.project_id, s.project_branch, s.service_name, s.node_ports, s.ingress,
-  p.name AS project_name
+  project_id, mode, allowlist
 FROM
-  services s
+  preview_protections
+WHERE
+  project_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPreviewProtection(ctx context.Context, projectID uuid.UUID) (PreviewProtection, error) {
+	row := q.db.QueryRow(ctx, getPreviewProtection, projectID)
+	var i PreviewProtection
+	err := row.Scan(&i.ProjectID, &i.Mode, &i.Allowlist)
+	return i, err
+}
+
+const getProject = `-- name: GetProject :one
+SELECT
+  id, name
+FROM
+  projects
+WHERE
+  id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetProject(ctx context.Context, id uuid.UUID) (Project, error) {
+	row := q.db.QueryRow(ctx, getProject, id)
+	var i Project
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const getProjectBranches = `-- name: GetProjectBranches :many
+SELECT
+  project_branch
+FROM
+  services s
+WHERE
+  s.project_id = $1
+UNION
+SELECT
+  project_branch
+FROM
+  volumes v
+WHERE
+  v.project_id = $1
+`
+
+func (q *Queries) GetProjectBranches(ctx context.Context, projectID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, getProjectBranches, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var project_branch string
+		if err := rows.Scan(&project_branch); err != nil {
+			return nil, err
+		}
+		items = append(items, project_branch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectById = `-- name: GetProjectById :one
+SELECT
+  id,
+  name
+FROM
+  projects
+WHERE
+  id = $1
+`
+
+func (q *Queries) GetProjectById(ctx context.Context, id uuid.UUID) (Project, error) {
+	row := q.db.QueryRow(ctx, getProjectById, id)
+	var i Project
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const getProjectByName = `-- name: GetProjectByName :one
+SELECT
+  id, name
+FROM
+  projects
+WHERE
+  name = $1
+LIMIT 1
+`
+
+func (q *Queries) GetProjectByName(ctx context.Context, name string) (Project, error) {
+	row := q.db.QueryRow(ctx, getProjectByName, name)
+	var i Project
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const getProjects = `-- name: GetProjects :many
+SELECT
+  id, name
+FROM
+  projects
+ORDER BY
+  name
+`
+
+func (q *Queries) GetProjects(ctx context.Context) ([]Project, error) {
+	rows, err := q.db.Query(ctx, getProjects)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectsByUser = `-- name: GetProjectsByUser :many
+SELECT
+  p.id, p.name
+FROM
+  projects p
+  JOIN user_projects up ON p.id = up.project_id
+WHERE
+  up.user_id = $1
+ORDER BY
+  p.name
+`
+
+func (q *Queries) GetProjectsByUser(ctx context.Context, userID uuid.UUID) ([]Project, error) {
+	rows, err := q.db.Query(ctx, getProjectsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectSecrets = `-- name: GetProjectSecrets :one
+SELECT
+  s.data
+FROM
+  project_secrets s
+  JOIN projects p ON s.project_id = p.id
+WHERE
+  p.name = $1
+  AND s.project_branch = $2
+`
+
+type GetProjectSecretsParams struct {
+	ProjectName   string
+	ProjectBranch string
+}
+
+func (q *Queries) GetProjectSecrets(ctx context.Context, arg GetProjectSecretsParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getProjectSecrets, arg.ProjectName, arg.ProjectBranch)
+	var data []byte
+	err := row.Scan(&data)
+	return data, err
+}
+
+const getSecretVersion = `-- name: GetSecretVersion :one
+SELECT
+  project_id, project_branch, version, data, user_id, added, removed, changed, created_at
+FROM
+  secret_versions
+WHERE
+  project_id = $1
+  AND project_branch = $2
+  AND version = $3
+`
+
+type GetSecretVersionParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	Version       int32
+}
+
+func (q *Queries) GetSecretVersion(ctx context.Context, arg GetSecretVersionParams) (SecretVersion, error) {
+	row := q.db.QueryRow(ctx, getSecretVersion, arg.ProjectID, arg.ProjectBranch, arg.Version)
+	var i SecretVersion
+	err := row.Scan(
+		&i.ProjectID,
+		&i.ProjectBranch,
+		&i.Version,
+		&i.Data,
+		&i.UserID,
+		&i.Added,
+		&i.Removed,
+		&i.Changed,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getSecretVersions = `-- name: GetSecretVersions :many
+SELECT
+  s.project_id,
+  s.project_branch,
+  s.version,
+  s.user_id,
+  s.added,
+  s.removed,
+  s.changed,
+  s.created_at,
+  u.username
+FROM
+  secret_versions s
+  LEFT JOIN users u ON s.user_id = u.id
+WHERE
+  s.project_id = $1
+  AND s.project_branch = $2
+ORDER BY
+  s.version DESC
+`
+
+type GetSecretVersionsParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+}
+
+type GetSecretVersionsRow struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	Version       int32
+	UserID        pgtype.UUID
+	Added         []string
+	Removed       []string
+	Changed       []string
+	CreatedAt     pgtype.Timestamptz
+	Username      pgtype.Text
+}
+
+func (q *Queries) GetSecretVersions(ctx context.Context, arg GetSecretVersionsParams) ([]GetSecretVersionsRow, error) {
+	rows, err := q.db.Query(ctx, getSecretVersions, arg.ProjectID, arg.ProjectBranch)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSecretVersionsRow
+	for rows.Next() {
+		var i GetSecretVersionsRow
+		if err := rows.Scan(
+			&i.ProjectID,
+			&i.ProjectBranch,
+			&i.Version,
+			&i.UserID,
+			&i.Added,
+			&i.Removed,
+			&i.Changed,
+			&i.CreatedAt,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getService = `-- name: GetService :one
+SELECT
+  id, project_id, project_branch, service_name, node_ports, ingress
+FROM
+  services
+WHERE
+  id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetService(ctx context.Context, id uuid.UUID) (Service, error) {
+	row := q.db.QueryRow(ctx, getService, id)
+	var i Service
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.ProjectBranch,
+		&i.ServiceName,
+		&i.NodePorts,
+		&i.Ingress,
+	)
+	return i, err
+}
+
+const getServiceByIngress = `-- name: GetServiceByIngress :one
+SELECT
+  id, project_id, project_branch, service_name, node_ports, ingress
+FROM
+  services
+WHERE
+  ingress = $1
+LIMIT 1
+`
+
+func (q *Queries) GetServiceByIngress(ctx context.Context, ingress pgtype.Text) (Service, error) {
+	row := q.db.QueryRow(ctx, getServiceByIngress, ingress)
+	var i Service
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.ProjectBranch,
+		&i.ServiceName,
+		&i.NodePorts,
+		&i.Ingress,
+	)
+	return i, err
+}
+
+const getServiceByName = `-- name: GetServiceByName :one
+SELECT
+  id, project_id, project_branch, service_name, node_ports, ingress
+FROM
+  services
+WHERE
+  service_name = $1
+  AND project_id = $2
+  AND project_branch = $3
+LIMIT 1
+`
+
+type GetServiceByNameParams struct {
+	ServiceName   string
+	ProjectID     uuid.UUID
+	ProjectBranch string
+}
+
+func (q *Queries) GetServiceByName(ctx context.Context, arg GetServiceByNameParams) (Service, error) {
+	row := q.db.QueryRow(ctx, getServiceByName, arg.ServiceName, arg.ProjectID, arg.ProjectBranch)
+	var i Service
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.ProjectBranch,
+		&i.ServiceName,
+		&i.NodePorts,
+		&i.Ingress,
+	)
+	return i, err
+}
+
+const getServicesByProject = `-- name: GetServicesByProject :many
+SELECT
+  id, project_id, project_branch, service_name, node_ports, ingress
+FROM
+  services
+WHERE
+  project_id = $1
+  AND project_branch = $2
+ORDER BY
+  service_name
+`
+
+type GetServicesByProjectParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+}
+
+func (q *Queries) GetServicesByProject(ctx context.Context, arg GetServicesByProjectParams) ([]Service, error) {
+	rows, err := q.db.Query(ctx, getServicesByProject, arg.ProjectID, arg.ProjectBranch)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Service
+	for rows.Next() {
+		var i Service
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.ProjectBranch,
+			&i.ServiceName,
+			&i.NodePorts,
+			&i.Ingress,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getServicesByUser = `-- name: GetServicesByUser :many
+SELECT
+  s.id, s.project_id, s.project_branch, s.service_name, s.node_ports, s.ingress,
+  p.name AS project_name
+FROM
+  services s
   JOIN projects p ON s.project_id = p.id
   JOIN user_projects up ON up.project_id = p.id
 WHERE
-  up.user_id = $1
+  up.user_id = $1
+ORDER BY
+  p.name,
+  s.service_name
+`
+
+type GetServicesByUserRow struct {
+	ID            uuid.UUID
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	ServiceName   string
+	NodePorts     []int32
+	Ingress       pgtype.Text
+	ProjectName   string
+}
+
+func (q *Queries) GetServicesByUser(ctx context.Context, userID uuid.UUID) ([]GetServicesByUserRow, error) {
+	rows, err := q.db.Query(ctx, getServicesByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetServicesByUserRow
+	for rows.Next() {
+		var i GetServicesByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.ProjectBranch,
+			&i.ServiceName,
+			&i.NodePorts,
+			&i.Ingress,
+			&i.ProjectName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnusedVolumeIdentifiers = `-- name: GetUnusedVolumeIdentifiers :many
+SELECT
+  identifier
+FROM
+  volumes
+WHERE
+  project_id = $1
+  AND project_branch = $2
+  AND NOT volume_name = ANY ($3::text[])
+`
+
+type GetUnusedVolumeIdentifiersParams struct {
+	ProjectID      uuid.UUID
+	ProjectBranch  string
+	ExcludeVolumes []string
+}
+
+func (q *Queries) GetUnusedVolumeIdentifiers(ctx context.Context, arg GetUnusedVolumeIdentifiersParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getUnusedVolumeIdentifiers, arg.ProjectID, arg.ProjectBranch, arg.ExcludeVolumes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var identifier uuid.UUID
+		if err := rows.Scan(&identifier); err != nil {
+			return nil, err
+		}
+		items = append(items, identifier)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnusedVolumes = `-- name: GetUnusedVolumes :many
+SELECT
+  identifier, volume_name, project_id, project_branch, size, storage, delete_after
+FROM
+  volumes
+WHERE
+  project_id = $1
+  AND project_branch = $2
+  AND NOT volume_name = ANY ($3::text[])
+ORDER BY
+  volume_name
+`
+
+type GetUnusedVolumesParams struct {
+	ProjectID      uuid.UUID
+	ProjectBranch  string
+	ExcludeVolumes []string
+}
+
+func (q *Queries) GetUnusedVolumes(ctx context.Context, arg GetUnusedVolumesParams) ([]Volume, error) {
+	rows, err := q.db.Query(ctx, getUnusedVolumes, arg.ProjectID, arg.ProjectBranch, arg.ExcludeVolumes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Volume
+	for rows.Next() {
+		var i Volume
+		if err := rows.Scan(
+			&i.Identifier,
+			&i.VolumeName,
+			&i.ProjectID,
+			&i.ProjectBranch,
+			&i.Size,
+			&i.Storage,
+			&i.DeleteAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserByApiKey = `-- name: GetUserByApiKey :one
+SELECT
+  id, username, api_key
+FROM
+  users
+WHERE
+  api_key = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserByApiKey(ctx context.Context, apiKey string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByApiKey, apiKey)
+	var i User
+	err := row.Scan(&i.ID, &i.Username, &i.ApiKey)
+	return i, err
+}
+
+const getVolume = `-- name: GetVolume :one
+SELECT
+  identifier, volume_name, project_id, project_branch, size, storage, delete_after
+FROM
+  volumes
+WHERE
+  volume_name = $1
+  AND project_id = $2
+  AND project_branch = $3
+`
+
+type GetVolumeParams struct {
+	VolumeName    string
+	ProjectID     uuid.UUID
+	ProjectBranch string
+}
+
+func (q *Queries) GetVolume(ctx context.Context, arg GetVolumeParams) (Volume, error) {
+	row := q.db.QueryRow(ctx, getVolume, arg.VolumeName, arg.ProjectID, arg.ProjectBranch)
+	var i Volume
+	err := row.Scan(
+		&i.Identifier,
+		&i.VolumeName,
+		&i.ProjectID,
+		&i.ProjectBranch,
+		&i.Size,
+		&i.Storage,
+		&i.DeleteAfter,
+	)
+	return i, err
+}
+
+const getVolumeIdentifier = `-- name: GetVolumeIdentifier :one
+SELECT
+  identifier
+FROM
+  volumes
+WHERE
+  volume_name = $1
+  AND project_id = $2
+  AND project_branch = $3
+`
+
+type GetVolumeIdentifierParams struct {
+	VolumeName    string
+	ProjectID     uuid.UUID
+	ProjectBranch string
+}
+
+func (q *Queries) GetVolumeIdentifier(ctx context.Context, arg GetVolumeIdentifierParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getVolumeIdentifier, arg.VolumeName, arg.ProjectID, arg.ProjectBranch)
+	var identifier uuid.UUID
+	err := row.Scan(&identifier)
+	return identifier, err
+}
+
+const getVolumesByBranch = `-- name: GetVolumesByBranch :many
+SELECT
+  identifier, volume_name, project_id, project_branch, size, storage, delete_after
+FROM
+  volumes
+WHERE
+  project_id = $1
+  AND project_branch = $2
 ORDER BY
-  p.name,
-  s.service_name
+  volume_name
 `
 
-type GetServicesByUserRow struct {
-	ID            uuid.UUID
+type GetVolumesByBranchParams struct {
 	ProjectID     uuid.UUID
 	ProjectBranch string
-	ServiceName   string
-	NodePorts     []int32
-	Ingress       pgtype.Text
-	ProjectName   string
 }
 
-func (q *Queries) GetServicesByUser(ctx context.Context, userID uuid.UUID) ([]GetServicesByUserRow, error) {
-	rows, err := q.db.Query(ctx, getServicesByUser, userID)
+func (q *Queries) GetVolumesByBranch(ctx context.Context, arg GetVolumesByBranchParams) ([]Volume, error) {
+	rows, err := q.db.Query(ctx, getVolumesByBranch, arg.ProjectID, arg.ProjectBranch)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetServicesByUserRow
+	var items []Volume
 	for rows.Next() {
-		var i GetServicesByUserRow
+		var i Volume
 		if err := rows.Scan(
-			&i.ID,
+			&i.Identifier,
+			&i.VolumeName,
 			&i.ProjectID,
 			&i.ProjectBranch,
-			&i.ServiceName,
-			&i.NodePorts,
-			&i.Ingress,
-			&i.ProjectName,
+			&i.Size,
+			&i.Storage,
+			&i.DeleteAfter,
 		); err != nil {
 			return nil, err
 		}
@@ -482,36 +1272,98 @@ func (q *Queries) GetServicesByUser(ctx context.Context, userID uuid.UUID) ([]Ge
 	return items, nil
 }
 
-const getUnusedVolumeIdentifiers = `-- name: GetUnusedVolumeIdentifiers :many
+const getVolumeSnapshot = `-- name: GetVolumeSnapshot :one
 SELECT
-  identifier
+  s.id, s.volume_identifier, s.project_id, s.method, s.size, s.created_at,
+  v.volume_name,
+  v.project_branch
 FROM
-  volumes
+  volume_snapshots s
+  JOIN volumes v ON s.volume_identifier = v.identifier
 WHERE
-  project_id = $1
-  AND project_branch = $2
-  AND NOT volume_name = ANY ($3::text[])
+  s.id = $1
+  AND s.project_id = $2
 `
 
-type GetUnusedVolumeIdentifiersParams struct {
-	ProjectID      uuid.UUID
-	ProjectBranch  string
-	ExcludeVolumes []string
+type GetVolumeSnapshotParams struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
 }
 
-func (q *Queries) GetUnusedVolumeIdentifiers(ctx context.Context, arg GetUnusedVolumeIdentifiersParams) ([]uuid.UUID, error) {
-	rows, err := q.db.Query(ctx, getUnusedVolumeIdentifiers, arg.ProjectID, arg.ProjectBranch, arg.ExcludeVolumes)
+type GetVolumeSnapshotRow struct {
+	ID               uuid.UUID
+	VolumeIdentifier uuid.UUID
+	ProjectID        uuid.UUID
+	Method           string
+	Size             int32
+	CreatedAt        pgtype.Timestamptz
+	VolumeName       string
+	ProjectBranch    string
+}
+
+func (q *Queries) GetVolumeSnapshot(ctx context.Context, arg GetVolumeSnapshotParams) (GetVolumeSnapshotRow, error) {
+	row := q.db.QueryRow(ctx, getVolumeSnapshot, arg.ID, arg.ProjectID)
+	var i GetVolumeSnapshotRow
+	err := row.Scan(
+		&i.ID,
+		&i.VolumeIdentifier,
+		&i.ProjectID,
+		&i.Method,
+		&i.Size,
+		&i.CreatedAt,
+		&i.VolumeName,
+		&i.ProjectBranch,
+	)
+	return i, err
+}
+
+const getVolumeSnapshotsByProject = `-- name: GetVolumeSnapshotsByProject :many
+SELECT
+  s.id, s.volume_identifier, s.project_id, s.method, s.size, s.created_at,
+  v.volume_name,
+  v.project_branch
+FROM
+  volume_snapshots s
+  JOIN volumes v ON s.volume_identifier = v.identifier
+WHERE
+  s.project_id = $1
+ORDER BY
+  s.created_at DESC
+`
+
+type GetVolumeSnapshotsByProjectRow struct {
+	ID               uuid.UUID
+	VolumeIdentifier uuid.UUID
+	ProjectID        uuid.UUID
+	Method           string
+	Size             int32
+	CreatedAt        pgtype.Timestamptz
+	VolumeName       string
+	ProjectBranch    string
+}
+
+func (q *Queries) GetVolumeSnapshotsByProject(ctx context.Context, projectID uuid.UUID) ([]GetVolumeSnapshotsByProjectRow, error) {
+	rows, err := q.db.Query(ctx, getVolumeSnapshotsByProject, projectID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []uuid.UUID
+	var items []GetVolumeSnapshotsByProjectRow
 	for rows.Next() {
-		var identifier uuid.UUID
-		if err := rows.Scan(&identifier); err != nil {
+		var i GetVolumeSnapshotsByProjectRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.VolumeIdentifier,
+			&i.ProjectID,
+			&i.Method,
+			&i.Size,
+			&i.CreatedAt,
+			&i.VolumeName,
+			&i.ProjectBranch,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, identifier)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -519,45 +1371,64 @@ func (q *Queries) GetUnusedVolumeIdentifiers(ctx context.Context, arg GetUnusedV
 	return items, nil
 }
 
-const getUserByApiKey = `-- name: GetUserByApiKey :one
+const getVolumeSnapshotsByVolume = `-- name: GetVolumeSnapshotsByVolume :many
 SELECT
-  id, username, api_key
+  id, volume_identifier, project_id, method, size, created_at
 FROM
-  users
+  volume_snapshots
 WHERE
-  api_key = $1
-LIMIT 1
+  volume_identifier = $1
 `
 
-func (q *Queries) GetUserByApiKey(ctx context.Context, apiKey string) (User, error) {
-	row := q.db.QueryRow(ctx, getUserByApiKey, apiKey)
-	var i User
-	err := row.Scan(&i.ID, &i.Username, &i.ApiKey)
-	return i, err
+func (q *Queries) GetVolumeSnapshotsByVolume(ctx context.Context, volumeIdentifier uuid.UUID) ([]VolumeSnapshot, error) {
+	rows, err := q.db.Query(ctx, getVolumeSnapshotsByVolume, volumeIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VolumeSnapshot
+	for rows.Next() {
+		var i VolumeSnapshot
+		if err := rows.Scan(
+			&i.ID,
+			&i.VolumeIdentifier,
+			&i.ProjectID,
+			&i.Method,
+			&i.Size,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getVolumeIdentifier = `-- name: GetVolumeIdentifier :one
+const hasSecretVersions = `-- name: HasSecretVersions :one
 SELECT
-  identifier
-FROM
-  volumes
-WHERE
-  volume_name = $1
-  AND project_id = $2
-  AND project_branch = $3
+  EXISTS (
+    SELECT
+      1
+    FROM
+      secret_versions
+    WHERE
+      project_id = $1
+      AND project_branch = $2)
 `
 
-type GetVolumeIdentifierParams struct {
-	VolumeName    string
+type HasSecretVersionsParams struct {
 	ProjectID     uuid.UUID
 	ProjectBranch string
 }
 
-func (q *Queries) GetVolumeIdentifier(ctx context.Context, arg GetVolumeIdentifierParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, getVolumeIdentifier, arg.VolumeName, arg.ProjectID, arg.ProjectBranch)
-	var identifier uuid.UUID
-	err := row.Scan(&identifier)
-	return identifier, err
+func (q *Queries) HasSecretVersions(ctx context.Context, arg HasSecretVersionsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasSecretVersions, arg.ProjectID, arg.ProjectBranch)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
 }
 
 const isUserInProject = `-- name: IsUserInProject :one
@@ -584,6 +1455,76 @@ func (q *Queries) IsUserInProject(ctx context.Context, arg IsUserInProjectParams
 	return exists, err
 }
 
+const releaseBranchNodePorts = `-- name: ReleaseBranchNodePorts :exec
+DELETE FROM node_ports
+WHERE project_id = $1
+  AND project_branch = $2
+`
+
+type ReleaseBranchNodePortsParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+}
+
+func (q *Queries) ReleaseBranchNodePorts(ctx context.Context, arg ReleaseBranchNodePortsParams) error {
+	_, err := q.db.Exec(ctx, releaseBranchNodePorts, arg.ProjectID, arg.ProjectBranch)
+	return err
+}
+
+const releaseUnusedNodePorts = `-- name: ReleaseUnusedNodePorts :exec
+DELETE FROM node_ports
+WHERE project_id = $1
+  AND project_branch = $2
+  AND service_name = $3
+  AND NOT (port::text || '/' || protocol) = ANY ($4::text[])
+`
+
+type ReleaseUnusedNodePortsParams struct {
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	ServiceName   string
+	KeepPorts     []string
+}
+
+func (q *Queries) ReleaseUnusedNodePorts(ctx context.Context, arg ReleaseUnusedNodePortsParams) error {
+	_, err := q.db.Exec(ctx, releaseUnusedNodePorts,
+		arg.ProjectID,
+		arg.ProjectBranch,
+		arg.ServiceName,
+		arg.KeepPorts,
+	)
+	return err
+}
+
+const reserveNodePort = `-- name: ReserveNodePort :exec
+INSERT INTO node_ports (node_port, project_id, project_branch, service_name, port, protocol)
+  VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (project_id, project_branch, service_name, port, protocol)
+  DO UPDATE SET
+    node_port = EXCLUDED.node_port
+`
+
+type ReserveNodePortParams struct {
+	NodePort      int32
+	ProjectID     uuid.UUID
+	ProjectBranch string
+	ServiceName   string
+	Port          int32
+	Protocol      string
+}
+
+func (q *Queries) ReserveNodePort(ctx context.Context, arg ReserveNodePortParams) error {
+	_, err := q.db.Exec(ctx, reserveNodePort,
+		arg.NodePort,
+		arg.ProjectID,
+		arg.ProjectBranch,
+		arg.ServiceName,
+		arg.Port,
+		arg.Protocol,
+	)
+	return err
+}
+
 const setServiceIngress = `-- name: SetServiceIngress :exec
 UPDATE
   services
@@ -625,3 +1566,142 @@ func (q *Queries) SetServiceNodePorts(ctx context.Context, arg SetServiceNodePor
 	_, err := q.db.Exec(ctx, setServiceNodePorts, arg.ID, arg.NodePorts)
 	return err
 }
+
+const setVolumeDeleteAfter = `-- name: SetVolumeDeleteAfter :exec
+UPDATE
+  volumes
+SET
+  delete_after = $2
+WHERE
+  identifier = $1
+`
+
+type SetVolumeDeleteAfterParams struct {
+	Identifier  uuid.UUID
+	DeleteAfter pgtype.Timestamptz
+}
+
+func (q *Queries) SetVolumeDeleteAfter(ctx context.Context, arg SetVolumeDeleteAfterParams) error {
+	_, err := q.db.Exec(ctx, setVolumeDeleteAfter, arg.Identifier, arg.DeleteAfter)
+	return err
+}
+
+const setVolumeSize = `-- name: SetVolumeSize :exec
+UPDATE
+  volumes
+SET
+  size = $2
+WHERE
+  identifier = $1
+`
+
+type SetVolumeSizeParams struct {
+	Identifier uuid.UUID
+	Size       int32
+}
+
+func (q *Queries) SetVolumeSize(ctx context.Context, arg SetVolumeSizeParams) error {
+	_, err := q.db.Exec(ctx, setVolumeSize, arg.Identifier, arg.Size)
+	return err
+}
+
+const upsertCertificate = `-- name: UpsertCertificate :execrows
+INSERT INTO certificates (domain, project_id, secret_name, not_after)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (domain)
+  DO UPDATE SET
+    secret_name = EXCLUDED.secret_name,
+    not_after = EXCLUDED.not_after
+  WHERE
+    certificates.project_id = EXCLUDED.project_id
+`
+
+type UpsertCertificateParams struct {
+	Domain     string
+	ProjectID  uuid.UUID
+	SecretName string
+	NotAfter   pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertCertificate(ctx context.Context, arg UpsertCertificateParams) (int64, error) {
+	result, err := q.db.Exec(ctx, upsertCertificate,
+		arg.Domain,
+		arg.ProjectID,
+		arg.SecretName,
+		arg.NotAfter,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertDomain = `-- name: UpsertDomain :execrows
+INSERT INTO domains (domain, project_id, service_name)
+  VALUES ($1, $2, $3)
+ON CONFLICT (domain)
+  DO UPDATE SET
+    service_name = EXCLUDED.service_name
+  WHERE
+    domains.project_id = EXCLUDED.project_id
+`
+
+type UpsertDomainParams struct {
+	Domain      string
+	ProjectID   uuid.UUID
+	ServiceName string
+}
+
+func (q *Queries) UpsertDomain(ctx context.Context, arg UpsertDomainParams) (int64, error) {
+	result, err := q.db.Exec(ctx, upsertDomain, arg.Domain, arg.ProjectID, arg.ServiceName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertPreviewProtection = `-- name: UpsertPreviewProtection :exec
+INSERT INTO preview_protections (project_id, mode, allowlist)
+  VALUES ($1, $2, $3)
+ON CONFLICT (project_id)
+  DO UPDATE SET
+    mode = EXCLUDED.mode,
+    allowlist = EXCLUDED.allowlist
+`
+
+type UpsertPreviewProtectionParams struct {
+	ProjectID uuid.UUID
+	Mode      string
+	Allowlist []string
+}
+
+func (q *Queries) UpsertPreviewProtection(ctx context.Context, arg UpsertPreviewProtectionParams) error {
+	_, err := q.db.Exec(ctx, upsertPreviewProtection, arg.ProjectID, arg.Mode, arg.Allowlist)
+	return err
+}
+
+const upsertProjectSecrets = `-- name: UpsertProjectSecrets :exec
+INSERT INTO project_secrets (project_id, project_branch, data)
+SELECT
+  id,
+  $1,
+  $2
+FROM
+  projects
+WHERE
+  name = $3
+ON CONFLICT (project_id, project_branch)
+  DO UPDATE SET
+    data = EXCLUDED.data, updated_at = now()
+`
+
+type UpsertProjectSecretsParams struct {
+	ProjectBranch string
+	Data          []byte
+	ProjectName   string
+}
+
+func (q *Queries) UpsertProjectSecrets(ctx context.Context, arg UpsertProjectSecretsParams) error {
+	_, err := q.db.Exec(ctx, upsertProjectSecrets, arg.ProjectBranch, arg.Data, arg.ProjectName)
+	return err
+}
@@ -22,18 +22,10 @@ func NewDatabase(db DBTX) *Database {
 }
 
 // EnsureSchema ensures the database schema is applied to the
-// Postgres database. The schema is applied to the database
-// if the schema is not detected.
+// Postgres database. The schema only creates missing tables and
+// columns, so it is applied on every startup to migrate existing
+// databases.
 func (db *Database) EnsureSchema(ctx context.Context) error {
-	exists, err := db.CheckProjectsTableExists(ctx)
-	if err != nil {
-		return fmt.Errorf("checking projects table existance: %w", err)
-	}
-
-	if exists {
-		return nil
-	}
-
 	if _, err := db.db.Exec(ctx, sql.Schema()); err != nil {
 		return fmt.Errorf("applying database schema: %w", err)
 	}
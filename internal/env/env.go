@@ -8,6 +8,7 @@ import (
 	"nimbus/internal/config"
 	"nimbus/internal/database"
 	"nimbus/internal/logging"
+	"nimbus/internal/secrets"
 )
 
 type envKeyType struct{}
@@ -18,6 +19,7 @@ var envKey envKeyType
 type Env struct {
 	Logger   *slog.Logger
 	Database database.Querier
+	Secrets  secrets.Store
 	Config   config.Config
 }
 
@@ -3,7 +3,9 @@ package kubernetes
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"slices"
+	"sort"
 	"time"
 
 	nimbusEnv "nimbus/internal/env"
@@ -56,6 +58,23 @@ func GenerateDeploymentSpec(
 		},
 	}
 
+	// wire connection details of shared database servers into other services
+	for _, other := range deploymentRequest.ProjectConfig.Services {
+		if other.Name == service.Name || !other.SharedServer {
+			continue
+		}
+		optional := true
+		spec.Template.Spec.Containers[0].EnvFrom = append(
+			spec.Template.Spec.Containers[0].EnvFrom, corev1.EnvFromSource{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: ConnectionSecretName(other.Name),
+					},
+					Optional: &optional,
+				},
+			})
+	}
+
 	if service.Command != nil {
 		spec.Template.Spec.Containers[0].Command = service.Command
 	}
@@ -63,17 +82,13 @@ func GenerateDeploymentSpec(
 		spec.Template.Spec.Containers[0].Args = service.Args
 	}
 
+	service.Volumes = ServiceVolumes(service)
+
 	switch service.Template {
 	case "postgres":
 		if service.Version == "" {
 			service.Version = "13"
 		}
-		if len(service.Volumes) == 0 {
-			service.Volumes = []models.Volume{{
-				Name:      fmt.Sprintf("%s-psql", service.Name),
-				MountPath: "/var/lib/postgresql/data",
-			}}
-		}
 
 		spec.Template.Spec.Containers[0].Image = fmt.Sprintf("postgres:%s", service.Version)
 		if checkEnvironment(service.Env, "POSTGRES_USER") == nil {
@@ -105,12 +120,6 @@ func GenerateDeploymentSpec(
 		if service.Version == "" {
 			service.Version = "6"
 		}
-		if len(service.Volumes) == 0 {
-			service.Volumes = []models.Volume{{
-				Name:      fmt.Sprintf("%s-redis", service.Name),
-				MountPath: "/data",
-			}}
-		}
 
 		spec.Template.Spec.Containers[0].Image = fmt.Sprintf("redis:%s", service.Version)
 		spec.Template.Spec.Containers[0].Ports = []corev1.ContainerPort{
@@ -120,11 +129,47 @@ func GenerateDeploymentSpec(
 			},
 		}
 
+	case "static":
+		if service.Version == "" {
+			service.Version = "stable-alpine"
+		}
+
+		err := CreateConfigMap(ctx, deploymentRequest.Namespace,
+			GenerateStaticConfigMap(deploymentRequest.Namespace, service.Name), env)
+		if err != nil {
+			return nil, fmt.Errorf("creating nginx config: %w", err)
+		}
+
+		spec.Template.Spec.Containers[0].Image = fmt.Sprintf("nginx:%s", service.Version)
+		spec.Template.Spec.Containers[0].Ports = []corev1.ContainerPort{
+			{
+				Name:          "http",
+				ContainerPort: defaultHTTPPort,
+			},
+		}
+		spec.Template.Spec.Volumes = append(spec.Template.Spec.Volumes, corev1.Volume{
+			Name: "nginx-config",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: StaticConfigMapName(service.Name),
+					},
+				},
+			},
+		})
+		spec.Template.Spec.Containers[0].VolumeMounts = append(
+			spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      "nginx-config",
+				MountPath: staticConfigPath,
+				ReadOnly:  true,
+			})
+
 	default:
 		for idx, port := range service.Network.Ports {
 			spec.Template.Spec.Containers[0].Ports = append(spec.Template.Spec.Containers[0].Ports, corev1.ContainerPort{
-				Name:          fmt.Sprintf("port-%d", idx),
-				ContainerPort: port,
+				Name:          port.PortName(idx),
+				ContainerPort: port.Port,
+				Protocol:      port.KubeProtocol(),
 			})
 		}
 	}
@@ -134,9 +179,13 @@ func GenerateDeploymentSpec(
 		if err != nil {
 			return nil, fmt.Errorf("failed to get volume identifiers: %w", err)
 		}
-		log.Printf("Volume map: %+v", volumeMap)
+		env.Logger.DebugContext(ctx, "mounting volumes",
+			slog.String("service", service.Name),
+			slog.Any("volumes", volumeMap))
 
+		seed := false
 		for name, volume := range volumeMap {
+			seed = seed || volume.Created
 			spec.Template.Spec.Volumes = append(spec.Template.Spec.Volumes, corev1.Volume{
 				Name: name,
 				VolumeSource: corev1.VolumeSource{
@@ -145,12 +194,28 @@ func GenerateDeploymentSpec(
 					},
 				},
 			})
+		}
+		// a volume can be mounted more than once, e.g. with different sub paths
+		for _, volume := range service.Volumes {
 			spec.Template.Spec.Containers[0].VolumeMounts = append(
 				spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
-					Name:      name,
+					Name:      volume.Name,
 					MountPath: volume.MountPath,
+					ReadOnly:  volume.ReadOnly,
+					SubPath:   volume.SubPath,
 				})
 		}
+
+		if seed && service.SeedFrom != "" {
+			sourceHost := SeedSourceHost(deploymentRequest.ProjectConfig.AppName, service)
+			env.Logger.DebugContext(ctx, "seeding new volumes",
+				slog.String("service", service.Name),
+				slog.String("source", sourceHost))
+			err = GenerateSeedSpec(&spec.Template.Spec, service, sourceHost)
+			if err != nil {
+				return nil, fmt.Errorf("generating seed spec: %w", err)
+			}
+		}
 	}
 
 	if service.Arch != "" {
@@ -225,6 +290,71 @@ func DeleteDeployment(ctx context.Context, namespace, name string, env *nimbusEn
 	return nil
 }
 
+// RestartDeployment replaces the pods of a deployment one by one, like
+// kubectl rollout restart, so they read their environment again.
+func RestartDeployment(ctx context.Context, namespace, name string, env *nimbusEnv.Env) error {
+	client := getClient(env).AppsV1().Deployments(namespace)
+
+	existing, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting deployment: %w", err)
+	}
+	if existing.Spec.Template.Annotations == nil {
+		existing.Spec.Template.Annotations = make(map[string]string)
+	}
+	existing.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"] = time.Now().Format(time.RFC3339)
+
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("updating deployment: %w", err)
+	}
+	return nil
+}
+
+// GetDeploymentsReadingSecret returns the names of the deployments in the
+// namespace which read any of the keys of a secret into their environment,
+// sorted by name.
+func GetDeploymentsReadingSecret(
+	ctx context.Context, namespace, secret string, keys []string, env *nimbusEnv.Env,
+) ([]string, error) {
+	deployments, err := getClient(env).AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing deployments: %w", err)
+	}
+
+	names := []string{}
+	for _, deployment := range deployments.Items {
+		if readsSecret(deployment.Spec.Template.Spec, secret, keys) {
+			names = append(names, deployment.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// readsSecret reports whether a container of the pod reads any of the keys
+// of a secret, through a secretKeyRef or by loading the whole secret.
+func readsSecret(pod corev1.PodSpec, secret string, keys []string) bool {
+	containers := append(slices.Clone(pod.InitContainers), pod.Containers...)
+	for _, container := range containers {
+		for _, source := range container.EnvFrom {
+			if source.SecretRef != nil && source.SecretRef.Name == secret {
+				return true
+			}
+		}
+		for _, variable := range container.Env {
+			ref := variable.ValueFrom
+			if ref == nil || ref.SecretKeyRef == nil || ref.SecretKeyRef.Name != secret {
+				continue
+			}
+			if slices.Contains(keys, ref.SecretKeyRef.Key) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func checkEnvironment(vars []corev1.EnvVar, key string) *string {
 	for _, v := range vars {
 		if v.Name == key {
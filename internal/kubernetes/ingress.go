@@ -6,7 +6,8 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
-	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"nimbus/internal/env"
@@ -18,82 +19,227 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// GenerateIngressSpec generates the ingresses of a public http service. The
+// first ingress serves the generated host and custom domains with
+// certificates from the cert-manager issuer. Custom domains with an uploaded
+// certificate, listed in certificates by domain, are served by a second
+// ingress referencing their TLS secrets, as cert-manager would otherwise
+// replace the uploaded certificates.
 func GenerateIngressSpec(namespace string, service *models.Service,
-	existingIngress *string, env *env.Env,
-) (*networkingv1.Ingress, error) {
-	if service.Template != "http" || !service.Public {
+	existingIngress *string, protection *models.PreviewProtection,
+	certificates map[string]string, env *env.Env,
+) ([]*networkingv1.Ingress, error) {
+	if !service.ServesHTTP() || !service.Public {
 		return nil, nil
 	}
 
-	randomString := GenerateRandomChars()
-	spec := networkingv1.IngressSpec{
-		Rules: []networkingv1.IngressRule{
-			{
-				Host: fmt.Sprintf("%s.%s", randomString, os.Getenv("DOMAIN")),
+	// the generated host is always the first rule, custom domains are aliases
+	host := fmt.Sprintf("%s.%s", GenerateRandomChars(), env.Config.Domain)
+	if existingIngress != nil {
+		host = *existingIngress
+	}
+	hosts := []string{host}
+	var customHosts []string
+	for _, domain := range service.Domains {
+		if _, ok := certificates[domain]; ok {
+			customHosts = append(customHosts, domain)
+		} else {
+			hosts = append(hosts, domain)
+		}
+	}
+
+	routes := service.Routes
+	if len(routes) == 0 {
+		routes = []models.Route{{Path: "/", Port: service.HTTPPort(), Service: service.Name}}
+	}
+
+	pathType := networkingv1.PathTypePrefix
+	paths := make([]networkingv1.HTTPIngressPath, 0, len(routes))
+	for _, route := range routes {
+		paths = append(paths, networkingv1.HTTPIngressPath{
+			Path:     route.Path,
+			PathType: &pathType,
+			Backend: networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: route.Service,
+					Port: networkingv1.ServiceBackendPort{
+						Number: route.Port,
+					},
+				},
+			},
+		})
+	}
+
+	rules := func(hosts []string) []networkingv1.IngressRule {
+		rules := make([]networkingv1.IngressRule, 0, len(hosts))
+		for _, h := range hosts {
+			rules = append(rules, networkingv1.IngressRule{
+				Host: h,
 				IngressRuleValue: networkingv1.IngressRuleValue{
 					HTTP: &networkingv1.HTTPIngressRuleValue{
-						Paths: []networkingv1.HTTPIngressPath{
-							{
-								Path: "/",
-								PathType: func() *networkingv1.PathType {
-									pt := networkingv1.PathTypePrefix
-									return &pt
-								}(),
-								Backend: networkingv1.IngressBackend{
-									Service: &networkingv1.IngressServiceBackend{
-										Name: service.Name,
-										Port: networkingv1.ServiceBackendPort{
-											Number: defaultHTTPPort,
-										},
-									},
-								},
-							},
-						},
+						Paths: paths,
 					},
 				},
-			},
-		},
+			})
+		}
+		return rules
+	}
+
+	spec := networkingv1.IngressSpec{
+		Rules: rules(hosts),
 		TLS: []networkingv1.IngressTLS{
 			{
-				Hosts: []string{
-					fmt.Sprintf("%s.%s", randomString, os.Getenv("DOMAIN")),
-				},
+				Hosts:      hosts,
 				SecretName: fmt.Sprintf("%s-%s", service.Name, "tls"),
 			},
 		},
 	}
-	if existingIngress != nil {
-		spec.Rules[0].Host = *existingIngress
+
+	if env.Config.Ingress.Class != "" {
+		spec.IngressClassName = &env.Config.Ingress.Class
+	}
+
+	annotations := generateIngressAnnotations(service, env)
+	for key, value := range generateProtectionAnnotations(protection) {
+		annotations[key] = value
 	}
 
-	return &networkingv1.Ingress{
+	ingresses := []*networkingv1.Ingress{{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-%s", service.Name, "ingress"),
-			Namespace: namespace,
-			Annotations: map[string]string{
-				"created": time.Now().Format(time.RFC3339),
-				"nginx.ingress.kubernetes.io/rewrite-target":    "/",
-				"nginx.ingress.kubernetes.io/ssl-redirect":      "true",
-				"nginx.ingress.kubernetes.io/cors-allow-origin": "*",
-				"cert-manager.io/cluster-issuer":                "letsencrypt-prod",
-			},
+			Name:        fmt.Sprintf("%s-%s", service.Name, "ingress"),
+			Namespace:   namespace,
+			Annotations: annotations,
 		},
 		Spec: spec,
-	}, nil
+	}}
+	if len(customHosts) == 0 {
+		return ingresses, nil
+	}
+
+	customSpec := networkingv1.IngressSpec{
+		IngressClassName: spec.IngressClassName,
+		Rules:            rules(customHosts),
+	}
+	for _, domain := range customHosts {
+		customSpec.TLS = append(customSpec.TLS, networkingv1.IngressTLS{
+			Hosts:      []string{domain},
+			SecretName: certificates[domain],
+		})
+	}
+	customAnnotations := generateIngressAnnotations(service, env)
+	delete(customAnnotations, "cert-manager.io/cluster-issuer")
+
+	return append(ingresses, &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        CertificateIngressName(service.Name),
+			Namespace:   namespace,
+			Annotations: customAnnotations,
+		},
+		Spec: customSpec,
+	}), nil
+}
+
+// CertificateIngressName returns the name of the ingress serving the custom
+// domains of a service which use uploaded certificates.
+func CertificateIngressName(service string) string {
+	return fmt.Sprintf("%s-ingress-certs", service)
+}
+
+// generateIngressAnnotations translates the ingress options of a service,
+// falling back to the server defaults, into nginx ingress annotations.
+func generateIngressAnnotations(service *models.Service, env *env.Env) map[string]string {
+	const prefix = "nginx.ingress.kubernetes.io/"
+	const websocketTimeout = "3600"
+
+	options := models.Ingress{}
+	if service.Ingress != nil {
+		options = *service.Ingress
+	}
+	defaults := env.Config.Ingress
+
+	annotations := map[string]string{
+		"created":                        time.Now().Format(time.RFC3339),
+		prefix + "ssl-redirect":          "true",
+		"cert-manager.io/cluster-issuer": env.Config.TLSIssuer,
+	}
+
+	// Normalise a copy, the service config is shared by all of its ingresses
+	configured := options.CorsOrigins
+	if len(configured) == 0 && defaults.CorsOrigins != "" {
+		configured = strings.Split(defaults.CorsOrigins, ",")
+	}
+	origins := make([]string, 0, len(configured))
+	for _, origin := range configured {
+		origins = append(origins, strings.TrimSpace(origin))
+	}
+	if len(origins) > 0 {
+		annotations[prefix+"enable-cors"] = "true"
+		annotations[prefix+"cors-allow-origin"] = strings.Join(origins, ", ")
+	}
+
+	maxBodySize := options.MaxBodySize
+	if maxBodySize == "" {
+		maxBodySize = defaults.MaxBodySize
+	}
+	if maxBodySize != "" {
+		annotations[prefix+"proxy-body-size"] = maxBodySize
+	}
+
+	timeout := defaults.ProxyTimeout
+	if options.ProxyTimeout > 0 {
+		timeout = strconv.Itoa(int(options.ProxyTimeout))
+	} else if options.Protocol == "websocket" {
+		// keep idle websocket connections open
+		timeout = websocketTimeout
+	}
+	if timeout != "" {
+		annotations[prefix+"proxy-read-timeout"] = timeout
+		annotations[prefix+"proxy-send-timeout"] = timeout
+	}
+
+	if options.Protocol == "grpc" {
+		annotations[prefix+"backend-protocol"] = "GRPC"
+	}
+
+	rateLimit := defaults.RateLimit
+	if options.RateLimit > 0 {
+		rateLimit = strconv.Itoa(int(options.RateLimit))
+	}
+	if rateLimit != "" && rateLimit != "0" {
+		annotations[prefix+"limit-rps"] = rateLimit
+	}
+
+	return annotations
 }
 
 func CreateIngress(
 	ctx context.Context, namespace string, ingress *networkingv1.Ingress, env *env.Env,
 ) (*networkingv1.Ingress, error) {
-	_, err := getClient(env).NetworkingV1().Ingresses(namespace).Create(
-		ctx, ingress, metav1.CreateOptions{})
-	if errors.IsAlreadyExists(err) {
-		return ingress, nil
+	client := getClient(env).NetworkingV1().Ingresses(namespace)
+
+	existing, err := client.Get(ctx, ingress.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		created, err := client.Create(ctx, ingress, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("creating ingress: %w", err)
+		}
+		return created, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting ingress: %w", err)
+	}
+
+	// keep the original creation time so existing annotations stay stable
+	if created, ok := existing.Annotations["created"]; ok {
+		ingress.Annotations["created"] = created
 	}
+	existing.Annotations = ingress.Annotations
+	existing.Spec = ingress.Spec
+	updated, err := client.Update(ctx, existing, metav1.UpdateOptions{})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("updating ingress: %w", err)
 	}
-	return ingress, nil
+	return updated, nil
 }
 
 func DeleteIngress(ctx context.Context, namespace, host string, env *env.Env) error {
@@ -118,6 +264,16 @@ func DeleteIngress(ctx context.Context, namespace, host string, env *env.Env) er
 	return fmt.Errorf("no ingress found with host %s", host)
 }
 
+// DeleteIngressByName deletes an ingress, ignoring ingresses which do not
+// exist.
+func DeleteIngressByName(ctx context.Context, namespace, name string, env *env.Env) error {
+	err := getClient(env).NetworkingV1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("deleting ingress %s: %w", name, err)
+	}
+	return nil
+}
+
 func GenerateRandomChars() string {
 	const numBytes = 8
 	randBytes := make([]byte, numBytes)
@@ -8,14 +8,17 @@ import (
 
 	"nimbus/internal/env"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
 var (
-	client *kubernetes.Clientset
-	once   sync.Once
+	client        *kubernetes.Clientset
+	dynamicClient *dynamic.DynamicClient
+	restConfig    *rest.Config
+	once          sync.Once
 )
 
 func getClient(env *env.Env) *kubernetes.Clientset {
@@ -41,11 +44,23 @@ func getClient(env *env.Env) *kubernetes.Clientset {
 			}
 		}
 
+		restConfig = config
 		client, err = kubernetes.NewForConfig(config)
 		if err != nil {
 			log.Fatalf("Failed to create Kubernetes client: %v", err)
 		}
+		dynamicClient, err = dynamic.NewForConfig(config)
+		if err != nil {
+			log.Fatalf("Failed to create dynamic Kubernetes client: %v", err)
+		}
 	})
 
 	return client
 }
+
+// getDynamicClient returns a client for resources without typed clients,
+// such as the CSI snapshot API.
+func getDynamicClient(env *env.Env) *dynamic.DynamicClient {
+	getClient(env)
+	return dynamicClient
+}
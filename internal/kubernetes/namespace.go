@@ -43,6 +43,27 @@ func ValidateNamespace(ctx context.Context, name string, env *nimbusEnv.Env) (cr
 	return true, nil
 }
 
+// LabelNamespace sets the given labels on a namespace.
+func LabelNamespace(ctx context.Context, name string, labels map[string]string, env *nimbusEnv.Env) error {
+	client := getClient(env).CoreV1().Namespaces()
+
+	ns, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting namespace: %w", err)
+	}
+	if ns.Labels == nil {
+		ns.Labels = make(map[string]string)
+	}
+	for key, value := range labels {
+		ns.Labels[key] = value
+	}
+	_, err = client.Update(ctx, ns, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("updating namespace: %w", err)
+	}
+	return nil
+}
+
 func DeleteNamespace(ctx context.Context, name string, env *nimbusEnv.Env) error {
 	err := getClient(env).CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
 	if err != nil {
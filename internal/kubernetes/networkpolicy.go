@@ -0,0 +1,213 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	nimbusEnv "nimbus/internal/env"
+	"nimbus/internal/models"
+	"nimbus/internal/utils"
+
+	"github.com/google/uuid"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	ProjectLabel = "nimbus/project"
+	BranchLabel  = "nimbus/branch"
+
+	managedPolicyLabel = "nimbus/network-policy"
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+)
+
+// NamespaceLabels returns the labels identifying the project and branch of a
+// namespace, which network policies of other namespaces select on. Projects
+// are labelled with their ID, as sanitized names of distinct projects such as
+// foo_bar and foo-bar are the same.
+func NamespaceLabels(projectID uuid.UUID, branch string) map[string]string {
+	return map[string]string{
+		ProjectLabel: projectID.String(),
+		BranchLabel:  utils.ShortenLabel(utils.SanitizeLabel(branch)),
+	}
+}
+
+// GenerateNetworkPolicies generates the network policies of a branch
+// namespace. All ingress traffic is denied except for traffic from within
+// the namespace, from nimbus itself, from the ingress controller to public
+// http services and the services their routes forward to, to the node ports of public services, and from the
+// namespaces listed in allowFrom, whose project IDs are given in allowed by
+// project name. The main namespace additionally accepts traffic from the
+// project's preview branches, which seed from and share its databases.
+func GenerateNetworkPolicies(
+	namespace string, config *models.Config, projectID uuid.UUID, allowed map[string]uuid.UUID,
+	isMain bool, env *nimbusEnv.Env,
+) []*networkingv1.NetworkPolicy {
+	policy := func(name string, spec networkingv1.NetworkPolicySpec) *networkingv1.NetworkPolicy {
+		spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+		return &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{managedPolicyLabel: "true"},
+			},
+			Spec: spec,
+		}
+	}
+	fromNamespace := func(labels map[string]string) networkingv1.NetworkPolicyPeer {
+		return networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: labels},
+		}
+	}
+
+	policies := []*networkingv1.NetworkPolicy{
+		policy("nimbus-default-deny", networkingv1.NetworkPolicySpec{}),
+		policy("nimbus-allow-namespace", networkingv1.NetworkPolicySpec{
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{
+					{PodSelector: &metav1.LabelSelector{}},
+					fromNamespace(map[string]string{namespaceNameLabel: env.Config.NimbusNamespace}),
+				},
+			}},
+		}),
+	}
+
+	var httpServices []string
+	var publicPorts []networkingv1.NetworkPolicyPort
+	var publicServices []string
+	for _, service := range config.Services {
+		if service.ServesHTTP() && service.Public {
+			// routes forward to other services, which need not be public
+			targets := []string{service.Name}
+			for _, route := range service.Routes {
+				targets = append(targets, route.Service)
+			}
+			for _, target := range targets {
+				if !slices.Contains(httpServices, target) {
+					httpServices = append(httpServices, target)
+				}
+			}
+		}
+		ports := PublicPorts(&service)
+		if len(ports) > 0 {
+			publicServices = append(publicServices, service.Name)
+		}
+		for _, port := range ports {
+			protocol := port.KubeProtocol()
+			number := intstr.FromInt32(port.Port)
+			publicPorts = append(publicPorts, networkingv1.NetworkPolicyPort{
+				Protocol: &protocol,
+				Port:     &number,
+			})
+		}
+	}
+
+	if len(httpServices) > 0 {
+		policies = append(policies, policy("nimbus-allow-ingress-controller", networkingv1.NetworkPolicySpec{
+			PodSelector: selectServices(httpServices),
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{
+					fromNamespace(map[string]string{namespaceNameLabel: env.Config.Ingress.Namespace}),
+				},
+			}},
+		}))
+	}
+
+	if len(publicServices) > 0 {
+		policies = append(policies, policy("nimbus-allow-node-ports", networkingv1.NetworkPolicySpec{
+			PodSelector: selectServices(publicServices),
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"},
+				}},
+				Ports: publicPorts,
+			}},
+		}))
+	}
+
+	var peers []networkingv1.NetworkPolicyPeer
+	if isMain {
+		peers = append(peers, fromNamespace(map[string]string{
+			ProjectLabel: projectID.String(),
+		}))
+	}
+	for _, allow := range config.AllowFrom {
+		allowedID, ok := allowed[allow.Project]
+		if !ok {
+			continue
+		}
+		labels := NamespaceLabels(allowedID, allow.Branch)
+		if allow.Branch == "" {
+			delete(labels, BranchLabel)
+		}
+		peers = append(peers, fromNamespace(labels))
+	}
+	if len(peers) > 0 {
+		policies = append(policies, policy("nimbus-allow-from", networkingv1.NetworkPolicySpec{
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{From: peers}},
+		}))
+	}
+
+	return policies
+}
+
+func selectServices(services []string) metav1.LabelSelector {
+	return metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      "app",
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   services,
+		}},
+	}
+}
+
+// ApplyNetworkPolicies creates or updates the given network policies and
+// removes policies managed by nimbus which are no longer generated.
+func ApplyNetworkPolicies(
+	ctx context.Context, namespace string, policies []*networkingv1.NetworkPolicy, env *nimbusEnv.Env,
+) error {
+	client := getClient(env).NetworkingV1().NetworkPolicies(namespace)
+
+	names := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		names = append(names, policy.Name)
+
+		existing, err := client.Get(ctx, policy.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			_, err = client.Create(ctx, policy, metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("creating network policy %s: %w", policy.Name, err)
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("getting network policy %s: %w", policy.Name, err)
+		}
+
+		existing.Labels = policy.Labels
+		existing.Spec = policy.Spec
+		_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("updating network policy %s: %w", policy.Name, err)
+		}
+	}
+
+	existing, err := client.List(ctx, metav1.ListOptions{LabelSelector: managedPolicyLabel})
+	if err != nil {
+		return fmt.Errorf("listing network policies: %w", err)
+	}
+	for _, policy := range existing.Items {
+		if slices.Contains(names, policy.Name) {
+			continue
+		}
+		err = client.Delete(ctx, policy.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("deleting network policy %s: %w", policy.Name, err)
+		}
+	}
+
+	return nil
+}
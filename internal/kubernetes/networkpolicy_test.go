@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"slices"
+	"testing"
+
+	"nimbus/internal/config"
+	nimbusEnv "nimbus/internal/env"
+	"nimbus/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestNetworkPoliciesAllowRouteTargets(t *testing.T) {
+	env := nimbusEnv.Null()
+	env.Config = config.Config{
+		NimbusNamespace: "nimbus",
+		Ingress:         config.Ingress{Namespace: "ingress-nginx"},
+	}
+	appConfig := &models.Config{
+		Services: []models.Service{
+			{
+				Name:     "web",
+				Template: "http",
+				Public:   true,
+				Routes: []models.Route{
+					{Path: "/", Port: 80, Service: "web"},
+					{Path: "/api", Port: 8080, Service: "api"},
+				},
+			},
+			{Name: "api", Template: "http"},
+			{Name: "worker", Template: "http"},
+		},
+	}
+
+	policies := GenerateNetworkPolicies("app", appConfig, uuid.New(), nil, false, env)
+
+	for _, policy := range policies {
+		if policy.Name != "nimbus-allow-ingress-controller" {
+			continue
+		}
+		selected := policy.Spec.PodSelector.MatchExpressions[0].Values
+		if !slices.Equal(selected, []string{"web", "api"}) {
+			t.Errorf("expected web and api to be selected, got %v", selected)
+		}
+		return
+	}
+	t.Fatal("expected an ingress controller policy")
+}
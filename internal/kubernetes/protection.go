@@ -0,0 +1,100 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	nimbusEnv "nimbus/internal/env"
+	"nimbus/internal/models"
+
+	"golang.org/x/crypto/bcrypt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreviewAuthSecret is the name of the secret holding the htpasswd file used
+// for basic auth on preview branches.
+const PreviewAuthSecret = "nimbus-preview-auth"
+
+const (
+	ingressAnnotationPrefix = "nginx.ingress.kubernetes.io/"
+	previewAuthRealm        = "Preview environment"
+)
+
+var previewProtectionAnnotations = []string{
+	ingressAnnotationPrefix + "auth-type",
+	ingressAnnotationPrefix + "auth-secret",
+	ingressAnnotationPrefix + "auth-realm",
+	ingressAnnotationPrefix + "whitelist-source-range",
+}
+
+// generateProtectionAnnotations returns the nginx annotations enforcing the
+// preview protection. A nil protection returns no annotations.
+func generateProtectionAnnotations(protection *models.PreviewProtection) map[string]string {
+	annotations := map[string]string{}
+	if protection == nil {
+		return annotations
+	}
+
+	switch protection.Mode {
+	case "basic-auth":
+		annotations[ingressAnnotationPrefix+"auth-type"] = "basic"
+		annotations[ingressAnnotationPrefix+"auth-secret"] = PreviewAuthSecret
+		annotations[ingressAnnotationPrefix+"auth-realm"] = previewAuthRealm
+	case "ip-allowlist":
+		annotations[ingressAnnotationPrefix+"whitelist-source-range"] = strings.Join(protection.Allowlist, ",")
+	}
+	return annotations
+}
+
+// PreviewAuthEntry returns the bcrypt hashed htpasswd entry of the basic
+// auth credentials protecting previews.
+func PreviewAuthEntry(username, password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("hashing password: %w", err)
+	}
+	return fmt.Sprintf("%s:%s\n", username, hash), nil
+}
+
+// UpdatePreviewAuthSecret writes the htpasswd entry of a project's previews
+// to the auth secret of a preview namespace, as nginx only reads auth
+// secrets from the namespace of the ingress.
+func UpdatePreviewAuthSecret(ctx context.Context, namespace, entry string, env *nimbusEnv.Env) error {
+	return UpdateSecret(ctx, namespace, PreviewAuthSecret, map[string]string{"auth": entry}, env)
+}
+
+// DeletePreviewAuthSecret removes the auth secret of a preview namespace.
+func DeletePreviewAuthSecret(ctx context.Context, namespace string, env *nimbusEnv.Env) error {
+	return DeleteSecret(ctx, namespace, PreviewAuthSecret, env)
+}
+
+// ProtectIngresses replaces the preview protection annotations of all
+// ingresses in a namespace, so a changed setting applies without a deploy.
+func ProtectIngresses(
+	ctx context.Context, namespace string, protection *models.PreviewProtection, env *nimbusEnv.Env,
+) error {
+	client := getClient(env).NetworkingV1().Ingresses(namespace)
+	ingresses, err := client.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing ingress: %w", err)
+	}
+
+	for _, ingress := range ingresses.Items {
+		if ingress.Annotations == nil {
+			ingress.Annotations = map[string]string{}
+		}
+		for _, key := range previewProtectionAnnotations {
+			delete(ingress.Annotations, key)
+		}
+		for key, value := range generateProtectionAnnotations(protection) {
+			ingress.Annotations[key] = value
+		}
+		_, err = client.Update(ctx, &ingress, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("updating ingress %s: %w", ingress.Name, err)
+		}
+	}
+
+	return nil
+}
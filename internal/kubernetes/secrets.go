@@ -3,30 +3,71 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 
 	nimbusEnv "nimbus/internal/env"
+	"nimbus/internal/secrets"
+	"nimbus/internal/utils"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func GetSecret(ctx context.Context, namespace string, env *nimbusEnv.Env) (*corev1.Secret, error) {
-	client := getClient(env).CoreV1().Secrets(namespace)
-	secret, err := client.Get(ctx, fmt.Sprintf("%s-env", namespace), metav1.GetOptions{})
+func UpdateSecret(ctx context.Context, namespace, name string, data map[string]string, env *nimbusEnv.Env) error {
+	// TODO: remove this, it seems unnecessary
+	_, err := ValidateNamespace(ctx, namespace, env)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("validating namespace %s: %w", namespace, err)
+	}
+
+	client := getClient(env).CoreV1().Secrets(namespace)
+	var secret *corev1.Secret
+	secret, err = client.Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = client.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			StringData: data,
+			Type:       corev1.SecretTypeOpaque,
+		}, metav1.CreateOptions{})
+		return err
+	}
+
+	// replace the existing data entirely so removed keys are deleted
+	newData := make(map[string][]byte)
+	for k, v := range data {
+		newData[k] = []byte(v)
 	}
-	return secret, nil
+
+	secret.Data = newData
+	secret.StringData = nil
+	secret.Type = corev1.SecretTypeOpaque
+
+	_, err = client.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// EnvSecretName returns the name of the secret holding the resolved secrets
+// of a branch in its namespace.
+func EnvSecretName(project string) string {
+	return fmt.Sprintf("%s-env", project)
 }
 
-func GetSecretValues(ctx context.Context, namespace string, env *nimbusEnv.Env) (map[string]string, error) {
-	secret, err := GetSecret(ctx, namespace, env)
+// GetSecretData returns the values of a secret, or no values when the secret
+// does not exist.
+func GetSecretData(
+	ctx context.Context, namespace, name string, env *nimbusEnv.Env,
+) (map[string]string, error) {
+	secret, err := getClient(env).CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return map[string]string{}, nil
+	}
 	if err != nil {
-		if errors.IsNotFound(err) {
-			return map[string]string{}, nil
-		}
 		return nil, err
 	}
 	out := make(map[string]string, len(secret.Data))
@@ -36,54 +77,167 @@ func GetSecretValues(ctx context.Context, namespace string, env *nimbusEnv.Env)
 	return out, nil
 }
 
-func ListSecretNames(ctx context.Context, namespace string, env *nimbusEnv.Env) ([]string, error) {
-	secret, err := GetSecret(ctx, namespace, env)
+// DeleteSecret deletes a secret if it exists.
+func DeleteSecret(ctx context.Context, namespace, name string, env *nimbusEnv.Env) error {
+	err := getClient(env).CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// SyncSecrets writes the resolved secrets of a branch to the env secret of
+// its namespace, which the services of the branch read them from. It returns
+// the keys whose value changed, sorted by name.
+func SyncSecrets(
+	ctx context.Context, project, branch string, values map[string]string, env *nimbusEnv.Env,
+) ([]string, error) {
+	namespace := utils.GetSanitizedNamespace(project, branch)
+	previous, err := GetSecretData(ctx, namespace, EnvSecretName(project), env)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			return []string{}, nil
+		return nil, fmt.Errorf("getting env secret: %w", err)
+	}
+	err = UpdateSecret(ctx, namespace, EnvSecretName(project), values, env)
+	if err != nil {
+		return nil, fmt.Errorf("updating env secret: %w", err)
+	}
+
+	changes := secrets.Diff(previous, values)
+	changed := slices.Concat(changes.Added, changes.Removed, changes.Changed)
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// SecretEnv replaces environment variables set to ${KEY} with references to
+// KEY in the env secret of the project, so services read the current value
+// whenever they start. Variables naming unknown keys are left unchanged.
+func SecretEnv(project string, vars []corev1.EnvVar, values map[string]string) []corev1.EnvVar {
+	out := make([]corev1.EnvVar, len(vars))
+	for i, variable := range vars {
+		out[i] = variable
+		key, prefFound := strings.CutPrefix(variable.Value, "${")
+		key, suffFound := strings.CutSuffix(key, "}")
+		if !prefFound || !suffFound {
+			continue
 		}
+		if _, ok := values[key]; !ok {
+			continue
+		}
+		out[i].Value = ""
+		out[i].ValueFrom = &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: EnvSecretName(project)},
+				Key:                  key,
+			},
+		}
+	}
+	return out
+}
+
+const sharedDatabaseLabel = "nimbus/shared-database"
+
+// ConnectionSecretName returns the name of the secret holding the
+// connection details of a shared database service.
+func ConnectionSecretName(service string) string {
+	return fmt.Sprintf("%s-connection", service)
+}
+
+// UpdateConnectionSecret writes the connection details of a shared database
+// service to its connection secret in the given namespace.
+func UpdateConnectionSecret(
+	ctx context.Context, namespace, service string, data map[string]string, env *nimbusEnv.Env,
+) error {
+	client := getClient(env).CoreV1().Secrets(namespace)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConnectionSecretName(service),
+			Namespace: namespace,
+			Labels: map[string]string{
+				sharedDatabaseLabel: service,
+			},
+		},
+		StringData: data,
+		Type:       corev1.SecretTypeOpaque,
+	}
+
+	existing, err := client.Get(ctx, secret.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = client.Create(ctx, secret, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	existing.Labels = secret.Labels
+	existing.Data = nil
+	existing.StringData = data
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// GetConnectionSecrets returns the values of all shared database connection
+// secrets in the namespace keyed by service name.
+func GetConnectionSecrets(
+	ctx context.Context, namespace string, env *nimbusEnv.Env,
+) (map[string]map[string]string, error) {
+	secrets, err := getClient(env).CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: sharedDatabaseLabel,
+	})
+	if err != nil {
 		return nil, err
 	}
-	keys := make([]string, 0, len(secret.Data))
-	for k := range secret.Data {
-		keys = append(keys, k)
+	out := make(map[string]map[string]string, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		values := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			values[k] = string(v)
+		}
+		out[secret.Labels[sharedDatabaseLabel]] = values
 	}
-	sort.Strings(keys)
-	return keys, nil
+	return out, nil
 }
 
-func UpdateSecret(ctx context.Context, namespace, name string, data map[string]string, env *nimbusEnv.Env) error {
-	// TODO: remove this, it seems unnecessary
+// CertificateSecretName returns the name of the TLS secret holding the
+// uploaded certificate of a custom domain.
+func CertificateSecretName(domain string) string {
+	return fmt.Sprintf("tls-%s", domain)
+}
+
+// UpdateCertificateSecret stores an uploaded certificate and key of a custom
+// domain as a TLS secret.
+func UpdateCertificateSecret(
+	ctx context.Context, namespace, domain string, cert, key []byte, env *nimbusEnv.Env,
+) error {
 	_, err := ValidateNamespace(ctx, namespace, env)
 	if err != nil {
 		return fmt.Errorf("validating namespace %s: %w", namespace, err)
 	}
 
 	client := getClient(env).CoreV1().Secrets(namespace)
-	var secret *corev1.Secret
-	secret, err = client.Get(ctx, name, metav1.GetOptions{})
+	data := map[string][]byte{
+		corev1.TLSCertKey:       cert,
+		corev1.TLSPrivateKeyKey: key,
+	}
+
+	existing, err := client.Get(ctx, CertificateSecretName(domain), metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		_, err = client.Create(ctx, &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
+				Name:      CertificateSecretName(domain),
 				Namespace: namespace,
 			},
-			StringData: data,
-			Type:       corev1.SecretTypeOpaque,
+			Data: data,
+			Type: corev1.SecretTypeTLS,
 		}, metav1.CreateOptions{})
 		return err
 	}
-
-	// replace the existing data entirely so removed keys are deleted
-	newData := make(map[string][]byte)
-	for k, v := range data {
-		newData[k] = []byte(v)
+	if err != nil {
+		return err
 	}
 
-	secret.Data = newData
-	secret.StringData = nil
-	secret.Type = corev1.SecretTypeOpaque
-
-	_, err = client.Update(ctx, secret, metav1.UpdateOptions{})
+	existing.Data = data
+	existing.StringData = nil
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
 	return err
 }
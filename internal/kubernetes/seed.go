@@ -0,0 +1,90 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"nimbus/internal/models"
+	"nimbus/internal/utils"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	seedVolumeName      = "seed"
+	postgresInitDirPath = "/docker-entrypoint-initdb.d"
+	redisDataPath       = "/data"
+)
+
+// SeedSourceHost returns the cluster DNS name of the service with the same
+// name in the namespace of the branch the service is seeded from.
+func SeedSourceHost(appName string, service *models.Service) string {
+	namespace := utils.GetSanitizedNamespace(appName, service.SeedFrom)
+	return fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, namespace)
+}
+
+// GenerateSeedSpec adds an init container to the pod spec which copies the
+// data of the source service into the freshly created volumes of the service
+// before it starts. Seeding is best effort, a failed copy leaves the service
+// with an empty volume instead of blocking the rollout.
+func GenerateSeedSpec(podSpec *corev1.PodSpec, service *models.Service, sourceHost string) error {
+	container := &podSpec.Containers[0]
+
+	switch service.Template {
+	case "postgres":
+		// the postgres entrypoint runs scripts in the init directory only when
+		// the data directory is empty, so the dump is applied on first start
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: seedVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      seedVolumeName,
+			MountPath: postgresInitDirPath,
+		})
+
+		script := fmt.Sprintf(
+			`PGPASSWORD="$POSTGRES_PASSWORD" pg_dump --no-owner --no-privileges `+
+				`-h %s -U "$POSTGRES_USER" -d "$POSTGRES_DB" -f %s/seed.sql `+
+				`|| (echo "failed to seed from %s" && rm -f %s/seed.sql)`,
+			sourceHost, postgresInitDirPath, sourceHost, postgresInitDirPath)
+		podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+			Name:    fmt.Sprintf("%s-seed", service.Name),
+			Image:   container.Image,
+			Env:     container.Env,
+			Command: []string{"sh", "-c", script},
+			VolumeMounts: []corev1.VolumeMount{{
+				Name:      seedVolumeName,
+				MountPath: postgresInitDirPath,
+			}},
+		})
+
+	case "redis":
+		var dataMount *corev1.VolumeMount
+		for idx, mount := range container.VolumeMounts {
+			if mount.MountPath == redisDataPath {
+				dataMount = &container.VolumeMounts[idx]
+			}
+		}
+		if dataMount == nil {
+			return fmt.Errorf("no volume mounted at %s", redisDataPath)
+		}
+
+		script := fmt.Sprintf(
+			`[ -f %s/dump.rdb ] || redis-cli -h %s --rdb %s/dump.rdb `+
+				`|| (echo "failed to seed from %s" && rm -f %s/dump.rdb)`,
+			redisDataPath, sourceHost, redisDataPath, sourceHost, redisDataPath)
+		podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+			Name:         fmt.Sprintf("%s-seed", service.Name),
+			Image:        container.Image,
+			Command:      []string{"sh", "-c", script},
+			VolumeMounts: []corev1.VolumeMount{*dataMount},
+		})
+
+	default:
+		return fmt.Errorf("seeding is not supported for template %q", service.Template)
+	}
+
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	"log/slog"
 	"time"
 
-	"nimbus/internal/database"
 	nimbusEnv "nimbus/internal/env"
 	"nimbus/internal/models"
 
@@ -21,9 +20,44 @@ const (
 	defaultHTTPPort = 80
 )
 
-func GenerateServiceSpec(namespace string,
-	newService *models.Service, oldService *database.Service,
-) (*corev1.Service, error) {
+// ServicePorts returns the ports exposed by the kubernetes service of a
+// service, including the fixed ports of templates.
+func ServicePorts(service *models.Service) []models.Port {
+	switch service.Template {
+	case "postgres":
+		return []models.Port{{Port: defaultPostgresPort, Name: "postgres"}}
+	case "redis":
+		return []models.Port{{Port: defaultRedisPort, Name: "redis"}}
+	case "static":
+		return []models.Port{{Port: defaultHTTPPort, Name: "http"}}
+	default:
+		return service.Network.Ports
+	}
+}
+
+// PublicPorts returns the ports of a service which are exposed on node
+// ports. Node ports are reserved in the same order.
+func PublicPorts(service *models.Service) []models.Port {
+	var ports []models.Port
+	for idx, port := range ServicePorts(service) {
+		if service.IsPublic(port) {
+			port.Name = port.PortName(idx)
+			ports = append(ports, port)
+		}
+	}
+	return ports
+}
+
+// PublicServiceName returns the name of the node port service exposing the
+// public ports of a service.
+func PublicServiceName(service string) string {
+	return fmt.Sprintf("%s-public", service)
+}
+
+// GenerateServiceSpec generates the cluster internal service of newService.
+// Every port is exposed under its own number so each ServicePort stays
+// unique.
+func GenerateServiceSpec(namespace string, newService *models.Service) (*corev1.Service, error) {
 	spec := corev1.ServiceSpec{
 		Selector: map[string]string{
 			"app": newService.Name,
@@ -32,65 +66,69 @@ func GenerateServiceSpec(namespace string,
 		Type:  corev1.ServiceTypeClusterIP,
 	}
 
-	nodePortEnabled := newService.Public && newService.Template != "http"
-
-	switch newService.Template {
-	case "postgres":
-		if nodePortEnabled {
-			spec.Type = corev1.ServiceTypeNodePort
-		}
+	hasHTTPPort := false
+	for idx, port := range ServicePorts(newService) {
 		spec.Ports = append(spec.Ports, corev1.ServicePort{
-			Name: "postgres",
-			Port: defaultPostgresPort,
+			Name:       port.PortName(idx),
+			Protocol:   port.KubeProtocol(),
+			Port:       port.Port,
+			TargetPort: intstr.FromInt32(port.Port),
 		})
-		if nodePortEnabled && oldService != nil && len(oldService.NodePorts) > 0 {
-			spec.Ports[0].NodePort = oldService.NodePorts[0]
-		}
+		hasHTTPPort = hasHTTPPort || port.Port == defaultHTTPPort
+	}
 
-	case "redis":
-		if nodePortEnabled {
-			spec.Type = corev1.ServiceTypeNodePort
-		}
+	// http services stay reachable on port 80 inside the cluster
+	if newService.ServesHTTP() && !hasHTTPPort {
 		spec.Ports = append(spec.Ports, corev1.ServicePort{
-			Name: "redis",
-			Port: defaultRedisPort,
+			Name:       "http",
+			Port:       defaultHTTPPort,
+			TargetPort: intstr.FromInt32(newService.HTTPPort()),
 		})
-		if nodePortEnabled && oldService != nil && len(oldService.NodePorts) > 0 {
-			spec.Ports[0].NodePort = oldService.NodePorts[0]
-		}
+	}
 
-	default:
-		if nodePortEnabled {
-			spec.Type = corev1.ServiceTypeNodePort
-		}
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      newService.Name,
+			Namespace: namespace,
+		},
+		Spec: spec,
+	}, nil
+}
 
-		for idx, port := range newService.Network.Ports {
-			if nodePortEnabled && oldService != nil && len(oldService.NodePorts) > idx {
-				spec.Ports = append(spec.Ports, corev1.ServicePort{
-					Name:     fmt.Sprintf("port-%d", idx),
-					Port:     port,
-					NodePort: oldService.NodePorts[idx],
-				})
-			} else {
-				// if we set the type as NodePort (not an HTTP template),
-				// then a NodePort will be be randomly selected
-				// otherwise, will use this port as ClusterIP
-				spec.Ports = append(spec.Ports, corev1.ServicePort{
-					Name:       fmt.Sprintf("port-%d", idx),
-					Port:       defaultHTTPPort,
-					TargetPort: intstr.FromInt(int(port)),
-				})
-			}
+// GeneratePublicServiceSpec generates the node port service exposing the
+// public ports of newService. nodePorts holds the reserved node port of each
+// public port.
+func GeneratePublicServiceSpec(namespace string,
+	newService *models.Service, nodePorts []int32,
+) *corev1.Service {
+	spec := corev1.ServiceSpec{
+		Selector: map[string]string{
+			"app": newService.Name,
+		},
+		Ports: []corev1.ServicePort{},
+		Type:  corev1.ServiceTypeNodePort,
+	}
+
+	for idx, port := range PublicPorts(newService) {
+		servicePort := corev1.ServicePort{
+			Name:       port.Name,
+			Protocol:   port.KubeProtocol(),
+			Port:       port.Port,
+			TargetPort: intstr.FromInt32(port.Port),
+		}
+		if len(nodePorts) > idx {
+			servicePort.NodePort = nodePorts[idx]
 		}
+		spec.Ports = append(spec.Ports, servicePort)
 	}
 
 	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      newService.Name,
+			Name:      PublicServiceName(newService.Name),
 			Namespace: namespace,
 		},
 		Spec: spec,
-	}, nil
+	}
 }
 
 func CreateService(
@@ -134,3 +172,42 @@ func DeleteService(ctx context.Context, namespace, name string, env *nimbusEnv.E
 
 	return nil
 }
+
+// GetUsedNodePorts returns the node ports used by services across the
+// cluster, except for the given service.
+func GetUsedNodePorts(
+	ctx context.Context, namespace, name string, env *nimbusEnv.Env,
+) (map[int32]bool, error) {
+	services, err := getClient(env).CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing services: %w", err)
+	}
+
+	used := make(map[int32]bool)
+	for _, service := range services.Items {
+		if service.Namespace == namespace && service.Name == name {
+			continue
+		}
+		for _, port := range service.Spec.Ports {
+			if port.NodePort != 0 {
+				used[port.NodePort] = true
+			}
+		}
+	}
+	return used, nil
+}
+
+// GenerateExternalServiceSpec generates a service which resolves to the
+// given host, so workloads can keep addressing it by its local name.
+func GenerateExternalServiceSpec(namespace, name, host string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:         corev1.ServiceTypeExternalName,
+			ExternalName: host,
+		},
+	}
+}
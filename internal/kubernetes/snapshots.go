@@ -0,0 +1,383 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"nimbus/internal/env"
+
+	"github.com/google/uuid"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// SnapshotMethodCSI means the snapshot is a CSI VolumeSnapshot taken by
+	// the storage driver.
+	SnapshotMethodCSI = "csi"
+	// SnapshotMethodCopy means the snapshot is a copy of the volume's files
+	// in a separate PVC, used when the storage class can't take snapshots.
+	SnapshotMethodCopy = "copy"
+
+	snapshotAPIGroup               = "snapshot.storage.k8s.io"
+	defaultSnapshotClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+	helperImage                    = "busybox:1.36"
+	snapshotTimeout                = 10 * time.Minute
+)
+
+var (
+	volumeSnapshotResource = schema.GroupVersionResource{
+		Group: snapshotAPIGroup, Version: "v1", Resource: "volumesnapshots",
+	}
+	volumeSnapshotClassResource = schema.GroupVersionResource{
+		Group: snapshotAPIGroup, Version: "v1", Resource: "volumesnapshotclasses",
+	}
+)
+
+// ErrSnapshotNotReady is returned when restoring a CSI snapshot the storage
+// driver has not finished taking yet.
+var ErrSnapshotNotReady = errors.New("snapshot is not ready")
+
+// SnapshotName returns the name of the VolumeSnapshot or PVC holding a
+// snapshot.
+func SnapshotName(id uuid.UUID) string {
+	return fmt.Sprintf("snapshot-%s", id.String())
+}
+
+// getSnapshotClass returns the VolumeSnapshotClass of the storage class's
+// driver, or an empty string when the cluster can't snapshot its volumes.
+func getSnapshotClass(ctx context.Context, storageClassName string, env *env.Env) (string, error) {
+	storageClass, err := getClient(env).StorageV1().StorageClasses().Get(
+		ctx, storageClassName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting storage class: %w", err)
+	}
+
+	classes, err := getDynamicClient(env).Resource(volumeSnapshotClassResource).List(ctx, metav1.ListOptions{})
+	if k8serrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		// the snapshot CRDs are not installed
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("listing volume snapshot classes: %w", err)
+	}
+
+	var class string
+	for _, item := range classes.Items {
+		driver, _, _ := unstructured.NestedString(item.Object, "driver")
+		if driver != storageClass.Provisioner {
+			continue
+		}
+		if item.GetAnnotations()[defaultSnapshotClassAnnotation] == "true" {
+			return item.GetName(), nil
+		}
+		if class == "" {
+			class = item.GetName()
+		}
+	}
+	return class, nil
+}
+
+// CreateVolumeSnapshot snapshots a PVC and returns the method used. CSI
+// snapshots are taken asynchronously by the storage driver, copies are
+// complete when this returns.
+func CreateVolumeSnapshot(
+	ctx context.Context, namespace, pvc string, id uuid.UUID, size int32, env *env.Env,
+) (string, error) {
+	client := getClient(env).CoreV1().PersistentVolumeClaims(namespace)
+	source, err := client.Get(ctx, pvc, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting pvc: %w", err)
+	}
+	tier := storageTierOf(source, env)
+
+	class, err := getSnapshotClass(ctx, tier.Class, env)
+	if err != nil {
+		return "", err
+	}
+
+	if class != "" {
+		snapshot := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": snapshotAPIGroup + "/v1",
+			"kind":       "VolumeSnapshot",
+			"metadata": map[string]any{
+				"name":      SnapshotName(id),
+				"namespace": namespace,
+			},
+			"spec": map[string]any{
+				"volumeSnapshotClassName": class,
+				"source": map[string]any{
+					"persistentVolumeClaimName": pvc,
+				},
+			},
+		}}
+		_, err = getDynamicClient(env).Resource(volumeSnapshotResource).Namespace(namespace).Create(
+			ctx, snapshot, metav1.CreateOptions{})
+		if err != nil {
+			return "", fmt.Errorf("creating volume snapshot: %w", err)
+		}
+		return SnapshotMethodCSI, nil
+	}
+
+	_, err = client.Create(ctx, generatePVCSpec(namespace, SnapshotName(id), size, tier), metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("creating snapshot pvc: %w", err)
+	}
+	err = runCopyJob(ctx, namespace, SnapshotName(id), pvc, SnapshotName(id), env)
+	if err != nil {
+		_ = client.Delete(ctx, SnapshotName(id), metav1.DeleteOptions{})
+		return "", err
+	}
+	return SnapshotMethodCopy, nil
+}
+
+// VolumeSnapshotReady reports whether a snapshot can be restored.
+func VolumeSnapshotReady(
+	ctx context.Context, namespace string, id uuid.UUID, method string, env *env.Env,
+) (bool, error) {
+	if method != SnapshotMethodCSI {
+		return true, nil
+	}
+
+	snapshot, err := getDynamicClient(env).Resource(volumeSnapshotResource).Namespace(namespace).Get(
+		ctx, SnapshotName(id), metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	ready, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+	return ready, nil
+}
+
+// RestoreVolumeSnapshot replaces the contents of a PVC with a snapshot. The
+// deployments mounting the PVC are scaled down while it is replaced and
+// scaled back up afterwards.
+func RestoreVolumeSnapshot(
+	ctx context.Context, namespace, pvc string, id uuid.UUID, method string, size int32, env *env.Env,
+) error {
+	ready, err := VolumeSnapshotReady(ctx, namespace, id, method, env)
+	if err != nil {
+		return fmt.Errorf("getting snapshot: %w", err)
+	}
+	if !ready {
+		return ErrSnapshotNotReady
+	}
+
+	replicas, err := scaleDownVolumeUsers(ctx, namespace, pvc, env)
+	defer func() {
+		// scale back up even when the request was cancelled
+		err := scaleDeployments(context.WithoutCancel(ctx), namespace, replicas, env)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to scale deployments back up", slog.Any("error", err))
+		}
+	}()
+	if err != nil {
+		return err
+	}
+	err = waitForVolumeUnused(ctx, namespace, pvc, env)
+	if err != nil {
+		return err
+	}
+
+	switch method {
+	case SnapshotMethodCSI:
+		// a PVC can only be populated from a snapshot when it is created
+		client := getClient(env).CoreV1().PersistentVolumeClaims(namespace)
+		existing, err := client.Get(ctx, pvc, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("getting pvc: %w", err)
+		}
+		tier := storageTierOf(existing, env)
+		err = client.Delete(ctx, pvc, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("deleting pvc: %w", err)
+		}
+		err = wait.PollUntilContextTimeout(ctx, time.Second, snapshotTimeout, true,
+			func(ctx context.Context) (bool, error) {
+				_, err := client.Get(ctx, pvc, metav1.GetOptions{})
+				if k8serrors.IsNotFound(err) {
+					return true, nil
+				}
+				return false, err
+			})
+		if err != nil {
+			return fmt.Errorf("waiting for pvc to be deleted: %w", err)
+		}
+
+		apiGroup := snapshotAPIGroup
+		spec := generatePVCSpec(namespace, pvc, size, tier)
+		spec.Spec.DataSource = &corev1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     "VolumeSnapshot",
+			Name:     SnapshotName(id),
+		}
+		_, err = client.Create(ctx, spec, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("creating pvc from snapshot: %w", err)
+		}
+		return nil
+
+	case SnapshotMethodCopy:
+		return runCopyJob(ctx, namespace, fmt.Sprintf("restore-%s", id.String()), SnapshotName(id), pvc, env)
+
+	default:
+		return fmt.Errorf("unknown snapshot method %q", method)
+	}
+}
+
+// DeleteVolumeSnapshot deletes the VolumeSnapshot or PVC holding a snapshot.
+func DeleteVolumeSnapshot(ctx context.Context, namespace string, id uuid.UUID, method string, env *env.Env) error {
+	var err error
+	if method == SnapshotMethodCSI {
+		err = getDynamicClient(env).Resource(volumeSnapshotResource).Namespace(namespace).Delete(
+			ctx, SnapshotName(id), metav1.DeleteOptions{})
+	} else {
+		err = getClient(env).CoreV1().PersistentVolumeClaims(namespace).Delete(
+			ctx, SnapshotName(id), metav1.DeleteOptions{})
+	}
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// runCopyJob replaces the files of the target PVC with those of the source
+// PVC and waits for the copy to finish.
+func runCopyJob(ctx context.Context, namespace, name, source, target string, env *env.Env) error {
+	var backoffLimit int32
+	client := getClient(env).BatchV1().Jobs(namespace)
+	_, err := client.Create(ctx, &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{{
+						Name:  "copy",
+						Image: helperImage,
+						Command: []string{"sh", "-c",
+							"set -o pipefail && find /target -mindepth 1 -delete && " +
+								"tar -C /source -cf - . | tar -C /target -xf -"},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "source", MountPath: "/source", ReadOnly: true},
+							{Name: "target", MountPath: "/target"},
+						},
+					}},
+					Volumes: []corev1.Volume{
+						{Name: "source", VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: source,
+								ReadOnly:  true,
+							},
+						}},
+						{Name: "target", VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: target,
+							},
+						}},
+					},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("creating copy job: %w", err)
+	}
+	defer func() {
+		propagation := metav1.DeletePropagationBackground
+		_ = client.Delete(context.WithoutCancel(ctx), name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	}()
+
+	err = wait.PollUntilContextTimeout(ctx, 2*time.Second, snapshotTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			job, err := client.Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			if job.Status.Failed > 0 {
+				return false, errors.New("copy job failed")
+			}
+			return job.Status.Succeeded > 0, nil
+		})
+	if err != nil {
+		return fmt.Errorf("waiting for copy job: %w", err)
+	}
+	return nil
+}
+
+// scaleDownVolumeUsers scales the deployments mounting a PVC to zero and
+// returns their previous replicas.
+func scaleDownVolumeUsers(ctx context.Context, namespace, pvc string, env *env.Env) (map[string]int32, error) {
+	mounts, err := GetVolumeMounts(ctx, namespace, env)
+	if err != nil {
+		return nil, err
+	}
+
+	client := getClient(env).AppsV1().Deployments(namespace)
+	replicas := make(map[string]int32)
+	for _, name := range mounts[pvc] {
+		scale, err := client.GetScale(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return replicas, fmt.Errorf("getting scale of %s: %w", name, err)
+		}
+		replicas[name] = scale.Spec.Replicas
+		scale.Spec.Replicas = 0
+		_, err = client.UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+		if err != nil {
+			return replicas, fmt.Errorf("scaling down %s: %w", name, err)
+		}
+	}
+	return replicas, nil
+}
+
+func scaleDeployments(ctx context.Context, namespace string, replicas map[string]int32, env *env.Env) error {
+	client := getClient(env).AppsV1().Deployments(namespace)
+	for name, count := range replicas {
+		scale, err := client.GetScale(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("getting scale of %s: %w", name, err)
+		}
+		scale.Spec.Replicas = count
+		_, err = client.UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("scaling %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// waitForVolumeUnused waits until no pod of the namespace mounts the PVC.
+func waitForVolumeUnused(ctx context.Context, namespace, pvc string, env *env.Env) error {
+	client := getClient(env).CoreV1().Pods(namespace)
+	err := wait.PollUntilContextTimeout(ctx, time.Second, snapshotTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			pods, err := client.List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return false, err
+			}
+			for _, pod := range pods.Items {
+				for _, volume := range pod.Spec.Volumes {
+					if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvc {
+						return false, nil
+					}
+				}
+			}
+			return true, nil
+		})
+	if err != nil {
+		return fmt.Errorf("waiting for pods to stop: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	nimbusEnv "nimbus/internal/env"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	StaticRootPath = "/usr/share/nginx/html"
+
+	staticConfigPath = "/etc/nginx/conf.d"
+)
+
+// staticSiteConfig serves the site root with a fallback to index.html for
+// client side routing. Fingerprinted assets are cached for a year while
+// documents are always revalidated.
+const staticSiteConfig = `server {
+    listen 80;
+    server_name _;
+    root ` + StaticRootPath + `;
+    index index.html;
+
+    gzip on;
+    gzip_types text/css application/javascript application/json image/svg+xml;
+
+    location / {
+        try_files $uri $uri/ /index.html;
+        add_header Cache-Control "no-cache";
+    }
+
+    location ~* \.(?:js|mjs|css|png|jpe?g|gif|svg|ico|webp|avif|woff2?|ttf|eot|map)$ {
+        try_files $uri =404;
+        expires 1y;
+        add_header Cache-Control "public, immutable";
+    }
+}
+`
+
+// StaticConfigMapName returns the name of the config map holding the nginx
+// configuration of a static service.
+func StaticConfigMapName(service string) string {
+	return fmt.Sprintf("%s-nginx", service)
+}
+
+// GenerateStaticConfigMap generates the nginx configuration of a static
+// service.
+func GenerateStaticConfigMap(namespace, service string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      StaticConfigMapName(service),
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			"default.conf": staticSiteConfig,
+		},
+	}
+}
+
+func CreateConfigMap(
+	ctx context.Context, namespace string, configMap *corev1.ConfigMap, env *nimbusEnv.Env,
+) error {
+	client := getClient(env).CoreV1().ConfigMaps(namespace)
+
+	existing, err := client.Get(ctx, configMap.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = client.Create(ctx, configMap, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("creating config map: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting config map: %w", err)
+	}
+
+	existing.Data = configMap.Data
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("updating config map: %w", err)
+	}
+	return nil
+}
+
+func DeleteConfigMap(ctx context.Context, namespace, name string, env *nimbusEnv.Env) error {
+	err := getClient(env).CoreV1().ConfigMaps(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to delete config map: %w", err)
+	}
+	return nil
+}
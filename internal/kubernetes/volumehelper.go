@@ -0,0 +1,174 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"nimbus/internal/env"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+const (
+	volumeHelperContainer = "helper"
+	volumeHelperMountPath = "/volume"
+	// volumeHelperDeadline stops helpers which were never cleaned up.
+	volumeHelperDeadline int64 = 3600
+)
+
+// ErrExtractFailed is returned when an uploaded archive can't be unpacked
+// into the volume, e.g. because it is not a gzipped tarball.
+var ErrExtractFailed = errors.New("extracting archive failed")
+
+// VolumeHelper is a short-lived pod mounting a PVC, used to read and write
+// the files of a volume through the API.
+type VolumeHelper struct {
+	namespace string
+	name      string
+	env       *env.Env
+}
+
+// StartVolumeHelper starts a helper pod mounting the PVC and waits for it to
+// run. The caller must stop the helper when done.
+func StartVolumeHelper(ctx context.Context, namespace, pvc string, env *env.Env) (*VolumeHelper, error) {
+	client := getClient(env).CoreV1().Pods(namespace)
+	deadline := volumeHelperDeadline
+	pod, err := client.Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "volume-helper-",
+			Namespace:    namespace,
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &deadline,
+			Containers: []corev1.Container{{
+				Name:    volumeHelperContainer,
+				Image:   helperImage,
+				Command: []string{"sleep", fmt.Sprintf("%d", deadline)},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "volume",
+					MountPath: volumeHelperMountPath,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "volume",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: pvc,
+					},
+				},
+			}},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("creating helper pod: %w", err)
+	}
+	helper := &VolumeHelper{namespace: namespace, name: pod.Name, env: env}
+
+	err = wait.PollUntilContextTimeout(ctx, time.Second, 2*time.Minute, true,
+		func(ctx context.Context) (bool, error) {
+			pod, err := client.Get(ctx, helper.name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			switch pod.Status.Phase {
+			case corev1.PodRunning:
+				return true, nil
+			case corev1.PodFailed, corev1.PodSucceeded:
+				return false, fmt.Errorf("helper pod stopped with phase %s", pod.Status.Phase)
+			default:
+				return false, nil
+			}
+		})
+	if err != nil {
+		_ = helper.Stop(context.WithoutCancel(ctx))
+		return nil, fmt.Errorf("waiting for helper pod: %w", err)
+	}
+	return helper, nil
+}
+
+// Stop deletes the helper pod.
+func (h *VolumeHelper) Stop(ctx context.Context) error {
+	var grace int64
+	return getClient(h.env).CoreV1().Pods(h.namespace).Delete(ctx, h.name, metav1.DeleteOptions{
+		GracePeriodSeconds: &grace,
+	})
+}
+
+// Exists reports whether the path exists on the volume.
+func (h *VolumeHelper) Exists(ctx context.Context, p string) (bool, error) {
+	err := h.exec(ctx, []string{"test", "-e", volumeHelperPath(p)}, nil, io.Discard)
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Archive writes the directory or file at path on the volume to w as a
+// gzipped tarball. Directories are archived with paths relative to them,
+// files under their base name.
+func (h *VolumeHelper) Archive(ctx context.Context, p string, w io.Writer) error {
+	script := `if [ -d "$1" ]; then tar czf - -C "$1" .; ` +
+		`else tar czf - -C "$(dirname "$1")" "$(basename "$1")"; fi`
+	return h.exec(ctx, []string{"sh", "-c", script, "sh", volumeHelperPath(p)}, nil, w)
+}
+
+// Extract unpacks the gzipped tarball read from r into the directory at path
+// on the volume, creating it when missing. Existing files are overwritten.
+func (h *VolumeHelper) Extract(ctx context.Context, p string, r io.Reader) error {
+	script := `mkdir -p "$1" && tar xzf - -C "$1"`
+	err := h.exec(ctx, []string{"sh", "-c", script, "sh", volumeHelperPath(p)}, r, io.Discard)
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return fmt.Errorf("%w: %w", ErrExtractFailed, err)
+	}
+	return err
+}
+
+func (h *VolumeHelper) exec(ctx context.Context, command []string, stdin io.Reader, stdout io.Writer) error {
+	req := getClient(h.env).CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(h.namespace).
+		Name(h.name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: volumeHelperContainer,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(restConfig, "POST", req.URL())
+	if err != nil {
+		return fmt.Errorf("creating executor: %w", err)
+	}
+	var stderr bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: &stderr,
+	})
+	if err != nil && stderr.Len() > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return err
+}
+
+// volumeHelperPath returns the location of a volume path in the helper pod.
+// Paths can't escape the volume.
+func volumeHelperPath(p string) string {
+	return path.Join(volumeHelperMountPath, path.Clean("/"+p))
+}
@@ -4,25 +4,34 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
+	"path/filepath"
+	"slices"
+	"time"
 
+	"nimbus/internal/config"
 	"nimbus/internal/database"
 	"nimbus/internal/env"
 	"nimbus/internal/models"
+	"nimbus/internal/utils"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 type VolumeInfo struct {
-	PVC       string
-	MountPath string
-	Size      int32
+	PVC  string
+	Size int32
+	// Created reports whether the PVC was created by this deploy and starts
+	// empty, also when its volume was recorded before
+	Created bool
 }
 
 func GetVolumeIdentifiers(
@@ -32,22 +41,26 @@ func GetVolumeIdentifiers(
 	volumeMap := make(map[string]VolumeInfo)
 
 	for _, volume := range service.Volumes {
-		if volume.Size == 0 {
-			volume.Size = 100 // default to 100Mi
+		created := false
+		volume.Size = volume.RequestedSize()
+		tier, ok := env.Config.StorageTier(volume.Storage)
+		if !ok {
+			return nil, fmt.Errorf("unknown storage tier %s", volume.Storage)
 		}
 
-		identifier, err := env.Database.GetVolumeIdentifier(ctx, database.GetVolumeIdentifierParams{
+		existing, err := env.Database.GetVolume(ctx, database.GetVolumeParams{
 			VolumeName:    volume.Name,
 			ProjectID:     deploymentRequest.ProjectID,
 			ProjectBranch: deploymentRequest.BranchName,
 		})
+		identifier := existing.Identifier
 		if errors.Is(err, pgx.ErrNoRows) {
 			env.Logger.DebugContext(
 				ctx, "volume identifier does not exist - creating one",
 				slog.String("volume-name", volume.Name),
 				slog.String("branch-name", deploymentRequest.BranchName))
 			identifier = uuid.New()
-			err = CreatePVC(ctx, deploymentRequest.Namespace, identifier, volume.Size, env)
+			err = CreatePVC(ctx, deploymentRequest.Namespace, identifier, volume.Size, tier, env)
 			if err != nil {
 				return nil, fmt.Errorf("creating pvc: %w", err)
 			}
@@ -57,30 +70,118 @@ func GetVolumeIdentifiers(
 				ProjectID:     deploymentRequest.ProjectID,
 				ProjectBranch: deploymentRequest.BranchName,
 				Size:          volume.Size,
+				Storage:       volume.Storage,
 			})
 			if err != nil {
 				return nil, fmt.Errorf("creating volume in database: %w", err)
 			}
+			created = true
 		} else if err != nil {
 			return nil, fmt.Errorf("getting volume identifier: %w", err)
 		} else if !CheckPVC(ctx, deploymentRequest.Namespace, fmt.Sprintf("pvc-%s", identifier), env) {
 			// ensure PVC in database actually exists (sanity check)
-			err = CreatePVC(ctx, deploymentRequest.Namespace, identifier, volume.Size, env)
+			env.Logger.WarnContext(ctx, "pvc of volume is missing - recreating it",
+				slog.String("volume-name", volume.Name),
+				slog.String("pvc", fmt.Sprintf("pvc-%s", identifier)))
+			err = CreatePVC(ctx, deploymentRequest.Namespace, identifier, volume.Size, tier, env)
+			if err != nil {
+				return nil, fmt.Errorf("recreating pvc: %w", err)
+			}
+			created = true
+			if volume.Size != existing.Size {
+				err = env.Database.SetVolumeSize(ctx, database.SetVolumeSizeParams{
+					Identifier: identifier,
+					Size:       volume.Size,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("updating volume size in database: %w", err)
+				}
+			}
+		} else if volume.Size > existing.Size {
+			env.Logger.DebugContext(ctx, "resizing volume",
+				slog.String("volume-name", volume.Name),
+				slog.Int("from", int(existing.Size)),
+				slog.Int("to", int(volume.Size)))
+			status, err := ResizePVC(
+				ctx, deploymentRequest.Namespace, fmt.Sprintf("pvc-%s", identifier), volume.Size, env)
+			if err != nil {
+				return nil, fmt.Errorf("resizing pvc: %w", err)
+			}
+			if status == VolumeResizing {
+				err = env.Database.SetVolumeSize(ctx, database.SetVolumeSizeParams{
+					Identifier: identifier,
+					Size:       volume.Size,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("updating volume size in database: %w", err)
+				}
+			}
+			deploymentRequest.VolumeResizes = append(deploymentRequest.VolumeResizes, models.VolumeResize{
+				Service: service.Name,
+				Volume:  volume.Name,
+				From:    existing.Size,
+				To:      volume.Size,
+				Status:  status,
+			})
+		}
+
+		if existing.DeleteAfter.Valid {
+			// the volume is declared again - keep it
+			env.Logger.DebugContext(ctx, "unscheduling volume deletion",
+				slog.String("volume-name", volume.Name))
+			err = env.Database.SetVolumeDeleteAfter(ctx, database.SetVolumeDeleteAfterParams{
+				Identifier: identifier,
+			})
 			if err != nil {
-				log.Printf("Error creating PVC: %s\n", err)
-				return nil, err
+				return nil, fmt.Errorf("unscheduling volume deletion: %w", err)
 			}
 		}
 
 		volumeMap[volume.Name] = VolumeInfo{
-			PVC:       fmt.Sprintf("pvc-%s", identifier),
-			MountPath: volume.MountPath,
+			PVC:     fmt.Sprintf("pvc-%s", identifier),
+			Created: created || volumeMap[volume.Name].Created,
 		}
 	}
 
 	return volumeMap, nil
 }
 
+// ServiceVolumes returns the volumes mounted by a service. Templates keeping
+// state mount a default volume when the service declares none.
+func ServiceVolumes(service *models.Service) []models.Volume {
+	if len(service.Volumes) > 0 {
+		return service.Volumes
+	}
+
+	switch service.Template {
+	case "postgres":
+		return []models.Volume{{
+			Name:      fmt.Sprintf("%s-psql", service.Name),
+			MountPath: "/var/lib/postgresql/data",
+		}}
+	case "redis":
+		return []models.Volume{{
+			Name:      fmt.Sprintf("%s-redis", service.Name),
+			MountPath: "/data",
+		}}
+	case "static":
+		return []models.Volume{{
+			Name:      fmt.Sprintf("%s-static", service.Name),
+			MountPath: StaticRootPath,
+		}}
+	}
+	return nil
+}
+
+// deploysOwnInstance reports whether a service is deployed on the branch,
+// rather than referencing main's service or sharing main's server.
+func deploysOwnInstance(service *models.Service, branch string) bool {
+	if service.From != "" {
+		return false
+	}
+	return !service.SharedServer || branch == "main" || branch == "master"
+}
+
 func CheckPVC(ctx context.Context, namespace string, name string, env *env.Env) bool {
 	client := getClient(env).CoreV1().PersistentVolumeClaims(namespace)
 
@@ -88,28 +189,93 @@ func CheckPVC(ctx context.Context, namespace string, name string, env *env.Env)
 	return err == nil
 }
 
-func CreatePVC(ctx context.Context, namespace string, identifier uuid.UUID, size int32, env *env.Env) error {
+func CreatePVC(
+	ctx context.Context, namespace string, identifier uuid.UUID, size int32, tier config.StorageTier, env *env.Env,
+) error {
 	client := getClient(env).CoreV1().PersistentVolumeClaims(namespace)
 
-	_, err := client.Create(ctx, &corev1.PersistentVolumeClaim{
+	_, err := client.Create(ctx,
+		generatePVCSpec(namespace, fmt.Sprintf("pvc-%s", identifier.String()), size, tier), metav1.CreateOptions{})
+
+	return err
+}
+
+func generatePVCSpec(namespace, name string, size int32, tier config.StorageTier) *corev1.PersistentVolumeClaim {
+	accessMode := corev1.ReadWriteMany
+	if tier.ReadWriteOnce() {
+		accessMode = corev1.ReadWriteOnce
+	}
+
+	return &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("pvc-%s", identifier.String()),
+			Name:      name,
 			Namespace: namespace,
 		},
 		Spec: corev1.PersistentVolumeClaimSpec{
 			AccessModes: []corev1.PersistentVolumeAccessMode{
-				corev1.ReadWriteMany,
+				accessMode,
 			},
 			Resources: corev1.VolumeResourceRequirements{
 				Requests: corev1.ResourceList{
 					corev1.ResourceStorage: resource.MustParse(fmt.Sprintf("%dMi", size)),
 				},
 			},
-			StorageClassName: &env.Config.NimbusStorageClass,
+			StorageClassName: &tier.Class,
 		},
-	}, metav1.CreateOptions{})
+	}
+}
 
-	return err
+// storageTierOf returns the storage class and access mode of an existing PVC.
+func storageTierOf(pvc *corev1.PersistentVolumeClaim, env *env.Env) config.StorageTier {
+	tier := config.StorageTier{Class: env.Config.NimbusStorageClass, AccessMode: config.AccessModeRWX}
+	if pvc.Spec.StorageClassName != nil {
+		tier.Class = *pvc.Spec.StorageClassName
+	}
+	if slices.Contains(pvc.Spec.AccessModes, corev1.ReadWriteOnce) {
+		tier.AccessMode = config.AccessModeRWO
+	}
+	return tier
+}
+
+const (
+	// VolumeResizing means the storage request of the PVC was raised and the
+	// volume is being expanded.
+	VolumeResizing = "resizing"
+	// VolumeResizeUnsupported means the storage class does not allow volume
+	// expansion and the PVC was left unchanged.
+	VolumeResizeUnsupported = "unsupported"
+)
+
+// ResizePVC raises the storage request of a PVC to size Mi when its storage
+// class allows volume expansion, and returns the resulting resize status.
+func ResizePVC(ctx context.Context, namespace, name string, size int32, env *env.Env) (string, error) {
+	client := getClient(env)
+
+	pvc, err := client.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting pvc: %w", err)
+	}
+	storageClass := env.Config.NimbusStorageClass
+	if pvc.Spec.StorageClassName != nil {
+		storageClass = *pvc.Spec.StorageClassName
+	}
+	class, err := client.StorageV1().StorageClasses().Get(ctx, storageClass, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting storage class %s: %w", storageClass, err)
+	}
+	if class.AllowVolumeExpansion == nil || !*class.AllowVolumeExpansion {
+		return VolumeResizeUnsupported, nil
+	}
+
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse(fmt.Sprintf("%dMi", size))
+	_, err = client.CoreV1().PersistentVolumeClaims(namespace).Update(ctx, pvc, metav1.UpdateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("updating pvc: %w", err)
+	}
+	return VolumeResizing, nil
 }
 
 func DeletePVC(ctx context.Context, namespace string, name string, env *env.Env) error {
@@ -118,3 +284,241 @@ func DeletePVC(ctx context.Context, namespace string, name string, env *env.Env)
 	err := client.Delete(ctx, name, metav1.DeleteOptions{})
 	return err
 }
+
+// VolumesPath is where the nimbus server mounts the root of the NFS share
+// backing the storage class.
+const VolumesPath = "/volumes"
+
+// GetVolumePath waits for the PVC to be bound and returns the directory of
+// its volume on the NFS share mounted by the server.
+func GetVolumePath(ctx context.Context, namespace, name string, env *env.Env) (string, error) {
+	client := getClient(env).CoreV1()
+
+	const (
+		interval = time.Second
+		timeout  = 30 * time.Second
+	)
+	var pvc *corev1.PersistentVolumeClaim
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		var err error
+		pvc, err = client.PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return pvc.Status.Phase == corev1.ClaimBound, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("waiting for pvc to be bound: %w", err)
+	}
+
+	pv, err := client.PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("getting persistent volume: %w", err)
+	}
+	if pv.Spec.NFS == nil {
+		return "", fmt.Errorf("persistent volume %s is not backed by nfs", pv.Name)
+	}
+
+	return filepath.Join(VolumesPath, filepath.Base(pv.Spec.NFS.Path)), nil
+}
+
+// GetPVC returns a PVC of the namespace.
+func GetPVC(ctx context.Context, namespace, name string, env *env.Env) (*corev1.PersistentVolumeClaim, error) {
+	return getClient(env).CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// GetVolumeMounts returns the services of a namespace mounting each PVC,
+// keyed by PVC name.
+func GetVolumeMounts(ctx context.Context, namespace string, env *env.Env) (map[string][]string, error) {
+	deployments, err := getClient(env).AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing deployments: %w", err)
+	}
+
+	mounts := make(map[string][]string)
+	for _, deployment := range deployments.Items {
+		for _, volume := range deployment.Spec.Template.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+			claim := volume.PersistentVolumeClaim.ClaimName
+			mounts[claim] = append(mounts[claim], deployment.Name)
+		}
+	}
+	return mounts, nil
+}
+
+const (
+	// VolumeDeleted means the unused volume was deleted.
+	VolumeDeleted = "deleted"
+	// VolumeScheduled means the unused volume is kept until its grace period
+	// ends.
+	VolumeScheduled = "scheduled"
+)
+
+// CleanupUnusedVolumes handles the volumes of the branch that are no longer
+// declared in the config. Volumes are scheduled for deletion once the grace
+// period ends and deleted by the first deploy after that, or by
+// ReapExpiredVolumes for branches which are not deployed again. Volumes
+// still mounted by a deployment are kept.
+func CleanupUnusedVolumes(
+	ctx context.Context, deploymentRequest *models.DeployRequest, gracePeriod time.Duration, env *env.Env,
+) error {
+	declared := make([]string, 0)
+	for _, volume := range deploymentRequest.ProjectConfig.Volumes {
+		declared = append(declared, volume.Name)
+	}
+	for _, service := range deploymentRequest.ProjectConfig.Services {
+		// default volumes are only mounted by services deploying their own
+		// instance, not by those using main's service or server
+		if len(service.Volumes) == 0 && !deploysOwnInstance(&service, deploymentRequest.BranchName) {
+			continue
+		}
+		for _, volume := range ServiceVolumes(&service) {
+			declared = append(declared, volume.Name)
+		}
+	}
+
+	unused, err := env.Database.GetUnusedVolumes(ctx, database.GetUnusedVolumesParams{
+		ProjectID:      deploymentRequest.ProjectID,
+		ProjectBranch:  deploymentRequest.BranchName,
+		ExcludeVolumes: declared,
+	})
+	if err != nil {
+		return fmt.Errorf("getting unused volumes: %w", err)
+	}
+	if len(unused) == 0 {
+		return nil
+	}
+
+	mounts, err := GetVolumeMounts(ctx, deploymentRequest.Namespace, env)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, volume := range unused {
+		pvcName := fmt.Sprintf("pvc-%s", volume.Identifier)
+		if services := mounts[pvcName]; len(services) > 0 {
+			env.Logger.WarnContext(ctx, "unused volume is still mounted",
+				slog.String("volume-name", volume.VolumeName),
+				slog.Any("services", services))
+			continue
+		}
+
+		deleteAfter := now.Add(gracePeriod)
+		if volume.DeleteAfter.Valid && volume.DeleteAfter.Time.Before(deleteAfter) {
+			deleteAfter = volume.DeleteAfter.Time
+		}
+		if deleteAfter.After(now) {
+			if !volume.DeleteAfter.Valid || !volume.DeleteAfter.Time.Equal(deleteAfter) {
+				env.Logger.DebugContext(ctx, "scheduling volume deletion",
+					slog.String("volume-name", volume.VolumeName),
+					slog.Time("delete-after", deleteAfter))
+				err = env.Database.SetVolumeDeleteAfter(ctx, database.SetVolumeDeleteAfterParams{
+					Identifier:  volume.Identifier,
+					DeleteAfter: pgtype.Timestamptz{Time: deleteAfter, Valid: true},
+				})
+				if err != nil {
+					return fmt.Errorf("scheduling volume deletion: %w", err)
+				}
+			}
+			deploymentRequest.VolumeCleanups = append(deploymentRequest.VolumeCleanups, models.VolumeCleanup{
+				Volume:      volume.VolumeName,
+				Status:      VolumeScheduled,
+				DeleteAfter: deleteAfter,
+			})
+			continue
+		}
+
+		env.Logger.DebugContext(ctx, "deleting unused volume",
+			slog.String("volume-name", volume.VolumeName),
+			slog.String("pvc", pvcName))
+		err = deleteVolume(ctx, deploymentRequest.Namespace, volume, env)
+		if err != nil {
+			return err
+		}
+		deploymentRequest.VolumeCleanups = append(deploymentRequest.VolumeCleanups, models.VolumeCleanup{
+			Volume:      volume.VolumeName,
+			Status:      VolumeDeleted,
+			DeleteAfter: deleteAfter,
+		})
+	}
+
+	return nil
+}
+
+// ReapExpiredVolumes deletes the unused volumes of all projects whose grace
+// period has ended, so retention is also enforced for branches which are
+// never deployed again. Volumes still mounted by a deployment are kept.
+func ReapExpiredVolumes(ctx context.Context, env *env.Env) error {
+	expired, err := env.Database.GetExpiredVolumes(ctx)
+	if err != nil {
+		return fmt.Errorf("getting expired volumes: %w", err)
+	}
+
+	projects := make(map[uuid.UUID]string)
+	mounts := make(map[string]map[string][]string)
+	for _, volume := range expired {
+		project, ok := projects[volume.ProjectID]
+		if !ok {
+			p, err := env.Database.GetProject(ctx, volume.ProjectID)
+			if err != nil {
+				return fmt.Errorf("getting project: %w", err)
+			}
+			project = p.Name
+			projects[volume.ProjectID] = project
+		}
+
+		namespace := utils.GetSanitizedNamespace(project, volume.ProjectBranch)
+		if _, ok := mounts[namespace]; !ok {
+			mounts[namespace], err = GetVolumeMounts(ctx, namespace, env)
+			if err != nil {
+				return err
+			}
+		}
+		pvcName := fmt.Sprintf("pvc-%s", volume.Identifier)
+		if services := mounts[namespace][pvcName]; len(services) > 0 {
+			env.Logger.WarnContext(ctx, "expired volume is still mounted",
+				slog.String("namespace", namespace),
+				slog.String("volume-name", volume.VolumeName),
+				slog.Any("services", services))
+			continue
+		}
+
+		env.Logger.InfoContext(ctx, "deleting expired volume",
+			slog.String("namespace", namespace),
+			slog.String("volume-name", volume.VolumeName),
+			slog.String("pvc", pvcName))
+		err = deleteVolume(ctx, namespace, volume, env)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteVolume deletes a volume with its snapshots and PVC.
+func deleteVolume(ctx context.Context, namespace string, volume database.Volume, env *env.Env) error {
+	snapshots, err := env.Database.GetVolumeSnapshotsByVolume(ctx, volume.Identifier)
+	if err != nil {
+		return fmt.Errorf("getting snapshots: %w", err)
+	}
+	for _, snapshot := range snapshots {
+		err = DeleteVolumeSnapshot(ctx, namespace, snapshot.ID, snapshot.Method, env)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to delete snapshot",
+				slog.String("snapshot", snapshot.ID.String()),
+				slog.Any("error", err))
+		}
+	}
+	err = DeletePVC(ctx, namespace, fmt.Sprintf("pvc-%s", volume.Identifier), env)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("deleting pvc: %w", err)
+	}
+	err = env.Database.DeleteVolume(ctx, volume.Identifier)
+	if err != nil {
+		return fmt.Errorf("deleting volume in database: %w", err)
+	}
+	return nil
+}
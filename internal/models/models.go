@@ -1,17 +1,51 @@
 package models
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
 	"nimbus/internal/database"
 
 	"github.com/google/uuid"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 type Config struct {
-	AppName             string    `yaml:"app"`
-	AllowBranchPreviews *bool     `yaml:"allowBranchPreviews,omitempty"`
-	Services            []Service `yaml:"services"`
+	AppName             string         `yaml:"app"`
+	AllowBranchPreviews *bool          `yaml:"allowBranchPreviews,omitempty"`
+	HostPattern         string         `yaml:"hostPattern,omitempty"`
+	AllowFrom           []AllowFrom    `yaml:"allowFrom,omitempty"`
+	VolumeGracePeriod   string         `yaml:"volumeGracePeriod,omitempty"` // e.g. "72h", "0" deletes immediately
+	Volumes             []SharedVolume `yaml:"volumes,omitempty"`
+	Services            []Service      `yaml:"services"`
+}
+
+// DefaultVolumeGracePeriod is how long volumes removed from nimbus.yaml are
+// kept before a deploy deletes them.
+const DefaultVolumeGracePeriod = 7 * 24 * time.Hour
+
+// VolumeRetention returns how long volumes removed from the config are kept
+// before they are deleted.
+func (c *Config) VolumeRetention() (time.Duration, error) {
+	if c.VolumeGracePeriod == "" {
+		return DefaultVolumeGracePeriod, nil
+	}
+	retention, err := time.ParseDuration(c.VolumeGracePeriod)
+	if err != nil {
+		return 0, fmt.Errorf("invalid volume grace period %s - expected a duration such as 72h", c.VolumeGracePeriod)
+	}
+	if retention < 0 {
+		return 0, errors.New("volume grace period must not be negative")
+	}
+	return retention, nil
 }
 
 type Service struct {
@@ -29,10 +63,177 @@ type Service struct {
 	Configs      []ConfigEntry   `yaml:"configs,omitempty"`
 	Command      []string        `yaml:"command,omitempty"`
 	Args         []string        `yaml:"args,omitempty"`
+	SeedFrom     string          `yaml:"seedFrom,omitempty"` // "main"
+	From         string          `yaml:"from,omitempty"`     // "main", previews use main's service
+	SharedServer bool            `yaml:"sharedServer,omitempty"`
+	Domains      []string        `yaml:"domains,omitempty"`
+	Routes       []Route         `yaml:"routes,omitempty"`
+	Ingress      *Ingress        `yaml:"ingress,omitempty"`
+}
+
+// AllowFrom grants the namespaces of another project access to the
+// services of this project. An empty branch allows every branch.
+type AllowFrom struct {
+	Project string `yaml:"project"`
+	Branch  string `yaml:"branch,omitempty"`
 }
 
 type Network struct {
-	Ports []int32 `yaml:"ports"`
+	Ports     []Port  `yaml:"ports"`
+	NodePorts []int32 `yaml:"nodePorts,omitempty"` // deprecated, use nodePort of the port
+	HTTPPort  int32   `yaml:"httpPort,omitempty"`
+}
+
+// ApplyNodePorts moves the node ports requested through the deprecated
+// nodePorts list onto the ports at the same index.
+func (n *Network) ApplyNodePorts() error {
+	if len(n.NodePorts) > len(n.Ports) {
+		return fmt.Errorf("nodePorts lists %d node ports for %d ports", len(n.NodePorts), len(n.Ports))
+	}
+	for i, nodePort := range n.NodePorts {
+		if nodePort == 0 {
+			continue
+		}
+		if n.Ports[i].NodePort != 0 && n.Ports[i].NodePort != nodePort {
+			return fmt.Errorf("port %d requests node port %d in both nodePort and nodePorts",
+				n.Ports[i].Port, nodePort)
+		}
+		n.Ports[i].NodePort = nodePort
+	}
+	n.NodePorts = nil
+	return nil
+}
+
+// Port is an entry of network.ports. It accepts a bare port number or the
+// object form.
+type Port struct {
+	Port     int32  `yaml:"port"`
+	Protocol string `yaml:"protocol,omitempty"` // "tcp" || "udp" || "sctp", defaults to tcp
+	Name     string `yaml:"name,omitempty"`
+	Public   *bool  `yaml:"public,omitempty"`   // defaults to the service's public
+	NodePort int32  `yaml:"nodePort,omitempty"` // requested node port, 0 picks one
+}
+
+func (p *Port) UnmarshalYAML(unmarshal func(any) error) error {
+	var number int32
+	if err := unmarshal(&number); err == nil {
+		*p = Port{Port: number}
+		return nil
+	}
+
+	type port Port // avoid recursing into UnmarshalYAML
+	var object port
+	if err := unmarshal(&object); err != nil {
+		return err
+	}
+	*p = Port(object)
+	return nil
+}
+
+// PortName returns the name of the port, which defaults to port-<idx>.
+func (p *Port) PortName(idx int) string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return fmt.Sprintf("port-%d", idx)
+}
+
+// KubeProtocol returns the kubernetes protocol of the port.
+func (p *Port) KubeProtocol() corev1.Protocol {
+	if p.Protocol == "" {
+		return corev1.ProtocolTCP
+	}
+	return corev1.Protocol(strings.ToUpper(p.Protocol))
+}
+
+// Validate checks the protocol and name of the port.
+func (p *Port) Validate() error {
+	if p.Port <= 0 || p.Port > 65535 {
+		return fmt.Errorf("invalid port %d", p.Port)
+	}
+	switch p.KubeProtocol() {
+	case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+	default:
+		return fmt.Errorf("unknown protocol %s - expected tcp, udp or sctp", p.Protocol)
+	}
+	if p.Name != "" {
+		if errs := validation.IsValidPortName(p.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid port name %s: %s", p.Name, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// Route forwards requests below Path on the service's host to Port of
+// Service. Service defaults to the service declaring the route and Port to
+// the target's http port.
+type Route struct {
+	Path    string `yaml:"path"`
+	Port    int32  `yaml:"port,omitempty"`
+	Service string `yaml:"service,omitempty"`
+}
+
+// Ingress overrides the server's ingress defaults for a public http service.
+type Ingress struct {
+	CorsOrigins  []string `yaml:"corsOrigins,omitempty"`
+	MaxBodySize  string   `yaml:"maxBodySize,omitempty"`  // e.g. "10m"
+	ProxyTimeout int32    `yaml:"proxyTimeout,omitempty"` // seconds
+	Protocol     string   `yaml:"protocol,omitempty"`     // "http" || "websocket" || "grpc"
+	RateLimit    int32    `yaml:"rateLimit,omitempty"`    // requests per second per client
+}
+
+var ingressSizePattern = regexp.MustCompile(`^[0-9]+[kKmMgG]?$`)
+
+// Validate checks the ingress options for values nginx would reject.
+func (i *Ingress) Validate() error {
+	switch i.Protocol {
+	case "", "http", "websocket", "grpc":
+	default:
+		return fmt.Errorf("unknown protocol %s - expected http, websocket or grpc", i.Protocol)
+	}
+	if i.MaxBodySize != "" && !ingressSizePattern.MatchString(i.MaxBodySize) {
+		return fmt.Errorf("invalid max body size %s - expected a size such as 10m", i.MaxBodySize)
+	}
+	if i.ProxyTimeout < 0 || i.RateLimit < 0 {
+		return errors.New("proxy timeout and rate limit must not be negative")
+	}
+	for _, origin := range i.CorsOrigins {
+		if strings.ContainsAny(origin, ", \t") {
+			return fmt.Errorf("invalid cors origin %q", origin)
+		}
+	}
+	return nil
+}
+
+// PreviewProtection restricts access to the ingresses of a project's preview
+// branches. The basic auth credentials are kept in a secret and never leave
+// the cluster.
+type PreviewProtection struct {
+	Mode      string   // "basic-auth" || "ip-allowlist"
+	Allowlist []string // ip addresses or cidr ranges
+}
+
+// Validate checks the mode and that the allowlist only contains ip addresses
+// or cidr ranges.
+func (p *PreviewProtection) Validate() error {
+	switch p.Mode {
+	case "basic-auth":
+		if len(p.Allowlist) > 0 {
+			return errors.New("allowlist is only supported in ip-allowlist mode")
+		}
+	case "ip-allowlist":
+		if len(p.Allowlist) == 0 {
+			return errors.New("allowlist must not be empty")
+		}
+	default:
+		return fmt.Errorf("unknown mode %s - expected basic-auth or ip-allowlist", p.Mode)
+	}
+	for _, entry := range p.Allowlist {
+		if _, _, err := net.ParseCIDR(entry); err != nil && net.ParseIP(entry) == nil {
+			return fmt.Errorf("invalid allowlist entry %s - expected an ip address or cidr range", entry)
+		}
+	}
+	return nil
 }
 
 type Override struct {
@@ -45,6 +246,99 @@ type Volume struct {
 	Name      string `yaml:"name"`
 	MountPath string `yaml:"mountPath"`
 	Size      int32  `yaml:"size,omitempty"`
+	Storage   string `yaml:"storage,omitempty"` // storage tier, defaults to NIMBUS_STORAGE_CLASS
+	ReadOnly  bool   `yaml:"readOnly,omitempty"`
+	SubPath   string `yaml:"subPath,omitempty"` // directory of the volume to mount
+}
+
+// SharedVolume is an entry of the top-level volumes. Services mount it by
+// name and take its size and storage tier from the declaration.
+type SharedVolume struct {
+	Name    string `yaml:"name"`
+	Size    int32  `yaml:"size,omitempty"`
+	Storage string `yaml:"storage,omitempty"`
+}
+
+// ResolveVolumes checks the top-level volumes and the volume mounts of the
+// services, and copies the size and storage tier of each shared volume to
+// the services mounting it.
+func (c *Config) ResolveVolumes() error {
+	shared := make(map[string]SharedVolume)
+	for _, volume := range c.Volumes {
+		if volume.Name == "" {
+			return errors.New("volumes must have a name")
+		}
+		if _, ok := shared[volume.Name]; ok {
+			return fmt.Errorf("volume %s is declared more than once", volume.Name)
+		}
+		if volume.Size < 0 {
+			return fmt.Errorf("volume %s has a negative size", volume.Name)
+		}
+		shared[volume.Name] = volume
+	}
+
+	for i := range c.Services {
+		service := &c.Services[i]
+		mountPaths := make(map[string]bool)
+		for j := range service.Volumes {
+			volume := &service.Volumes[j]
+			if volume.MountPath == "" {
+				return fmt.Errorf("volume %s of service %s has no mount path", volume.Name, service.Name)
+			}
+			if mountPaths[volume.MountPath] {
+				return fmt.Errorf("service %s mounts more than one volume at %s", service.Name, volume.MountPath)
+			}
+			mountPaths[volume.MountPath] = true
+			if volume.SubPath != "" {
+				subPath := path.Clean(volume.SubPath)
+				if path.IsAbs(subPath) || subPath == ".." || strings.HasPrefix(subPath, "../") {
+					return fmt.Errorf("volume %s of service %s has an invalid sub path %s - "+
+						"expected a relative path inside the volume", volume.Name, service.Name, volume.SubPath)
+				}
+				volume.SubPath = subPath
+			}
+
+			declared, ok := shared[volume.Name]
+			if !ok {
+				continue
+			}
+			if volume.Size != 0 || volume.Storage != "" {
+				return fmt.Errorf("volume %s of service %s is declared in volumes, "+
+					"set its size and storage there", volume.Name, service.Name)
+			}
+			volume.Size = declared.Size
+			volume.Storage = declared.Storage
+		}
+	}
+	return nil
+}
+
+// DefaultVolumeSize is the size in Mi of volumes without a size.
+const DefaultVolumeSize = 100
+
+// RequestedSize returns the size of the volume in Mi.
+func (v *Volume) RequestedSize() int32 {
+	if v.Size == 0 {
+		return DefaultVolumeSize
+	}
+	return v.Size
+}
+
+// VolumeResize reports the resize of a volume during a deploy.
+type VolumeResize struct {
+	Service string
+	Volume  string
+	From    int32
+	To      int32
+	Status  string // "resizing" || "unsupported"
+}
+
+// VolumeCleanup reports a volume removed from the config that was deleted or
+// scheduled for deletion during a deploy.
+type VolumeCleanup struct {
+	Volume      string
+	Status      string // "deleted" || "scheduled"
+	DeleteAfter time.Time
 }
 
 type ConfigEntry struct {
@@ -59,4 +353,53 @@ type DeployRequest struct {
 	ProjectConfig    Config
 	FileContent      []byte
 	ExistingServices []database.Service
+	// Secrets holds the resolved project secrets of the branch
+	Secrets map[string]string
+	// PreviewProtection is set when deploying a protected preview branch
+	PreviewProtection *PreviewProtection
+	// VolumeResizes collects the volumes resized during the deploy
+	VolumeResizes []VolumeResize
+	// VolumeCleanups collects the unused volumes handled during the deploy
+	VolumeCleanups []VolumeCleanup
+}
+
+// ServesHTTP reports whether the service is exposed through an ingress
+// rather than through node ports.
+func (s *Service) ServesHTTP() bool {
+	return s.Template == "http" || s.Template == "static"
+}
+
+// HTTPPort returns the container port receiving http traffic. It defaults to
+// the first network port, or port 80 when no ports are specified.
+func (s *Service) HTTPPort() int32 {
+	if s.Template == "static" {
+		return 80 //nolint:mnd
+	}
+	if s.Network.HTTPPort != 0 {
+		return s.Network.HTTPPort
+	}
+	if len(s.Network.Ports) > 0 {
+		return s.Network.Ports[0].Port
+	}
+	return 80 //nolint:mnd
+}
+
+// ExposesPort reports whether port is reachable through the service's
+// kubernetes service.
+func (s *Service) ExposesPort(port int32) bool {
+	if s.ServesHTTP() && port == s.HTTPPort() {
+		return true
+	}
+	return slices.ContainsFunc(s.Network.Ports, func(p Port) bool { return p.Port == port })
+}
+
+// IsPublic reports whether a port of the service is exposed on a node port.
+func (s *Service) IsPublic(port Port) bool {
+	if s.ServesHTTP() {
+		return false
+	}
+	if port.Public != nil {
+		return *port.Public
+	}
+	return s.Public
 }
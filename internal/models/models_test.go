@@ -0,0 +1,190 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestNetworkPorts(t *testing.T) {
+	content := []byte(`
+ports:
+  - 8080
+  - port: 53
+    protocol: udp
+    name: dns
+    public: true
+    nodePort: 30053
+`)
+
+	var network Network
+	if err := yaml.Unmarshal(content, &network); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(network.Ports) != 2 {
+		t.Fatalf("expected 2 ports, got %d", len(network.Ports))
+	}
+
+	bare := network.Ports[0]
+	if bare.Port != 8080 || bare.KubeProtocol() != corev1.ProtocolTCP || bare.PortName(0) != "port-0" {
+		t.Errorf("unexpected bare port %+v", bare)
+	}
+
+	object := network.Ports[1]
+	if object.Port != 53 || object.KubeProtocol() != corev1.ProtocolUDP || object.PortName(1) != "dns" {
+		t.Errorf("unexpected object port %+v", object)
+	}
+	if object.Public == nil || !*object.Public || object.NodePort != 30053 {
+		t.Errorf("expected public port with node port 30053, got %+v", object)
+	}
+	if err := object.Validate(); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+
+	invalid := Port{Port: 53, Protocol: "icmp"}
+	if err := invalid.Validate(); err == nil {
+		t.Error("expected error for unknown protocol")
+	}
+}
+
+func TestNetworkNodePorts(t *testing.T) {
+	content := []byte(`
+ports: [8080, 9090]
+nodePorts: [0, 30090]
+`)
+
+	var network Network
+	if err := yaml.Unmarshal(content, &network); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := network.ApplyNodePorts(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if network.Ports[0].NodePort != 0 || network.Ports[1].NodePort != 30090 {
+		t.Errorf("expected node port 30090 on the second port, got %+v", network.Ports)
+	}
+
+	tooMany := Network{Ports: []Port{{Port: 80}}, NodePorts: []int32{30080, 30081}}
+	if err := tooMany.ApplyNodePorts(); err == nil {
+		t.Error("expected error for more node ports than ports")
+	}
+	conflicting := Network{Ports: []Port{{Port: 80, NodePort: 30080}}, NodePorts: []int32{30081}}
+	if err := conflicting.ApplyNodePorts(); err == nil {
+		t.Error("expected error for conflicting node ports")
+	}
+}
+
+func TestPreviewProtection(t *testing.T) {
+	valid := []PreviewProtection{
+		{Mode: "basic-auth"},
+		{Mode: "ip-allowlist", Allowlist: []string{"10.0.0.0/8", "203.0.113.7", "2001:db8::/32"}},
+	}
+	for _, protection := range valid {
+		if err := protection.Validate(); err != nil {
+			t.Errorf("expected %+v to be valid, got %v", protection, err)
+		}
+	}
+
+	invalid := []PreviewProtection{
+		{Mode: "password"},
+		{Mode: "ip-allowlist"},
+		{Mode: "ip-allowlist", Allowlist: []string{"example.com"}},
+		{Mode: "basic-auth", Allowlist: []string{"10.0.0.0/8"}},
+	}
+	for _, protection := range invalid {
+		if err := protection.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", protection)
+		}
+	}
+}
+
+func TestVolumeRetention(t *testing.T) {
+	valid := map[string]time.Duration{
+		"":    DefaultVolumeGracePeriod,
+		"0":   0,
+		"72h": 72 * time.Hour,
+		"30m": 30 * time.Minute,
+	}
+	for value, expected := range valid {
+		config := Config{VolumeGracePeriod: value}
+		retention, err := config.VolumeRetention()
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", value, err)
+		}
+		if retention != expected {
+			t.Errorf("expected %v for %q, got %v", expected, value, retention)
+		}
+	}
+
+	for _, value := range []string{"7d", "-1h", "forever"} {
+		config := Config{VolumeGracePeriod: value}
+		if _, err := config.VolumeRetention(); err == nil {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+}
+
+func TestResolveVolumes(t *testing.T) {
+	content := []byte(`
+app: shop
+volumes:
+  - name: uploads
+    size: 500
+    storage: fast
+services:
+  - name: web
+    volumes:
+      - name: uploads
+        mountPath: /app/uploads
+  - name: worker
+    volumes:
+      - name: uploads
+        mountPath: /uploads
+        readOnly: true
+        subPath: ./images/
+      - name: cache
+        mountPath: /cache
+`)
+
+	var config Config
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := config.ResolveVolumes(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	web := config.Services[0].Volumes[0]
+	if web.Size != 500 || web.Storage != "fast" || web.ReadOnly {
+		t.Errorf("unexpected web volume %+v", web)
+	}
+	worker := config.Services[1].Volumes[0]
+	if worker.Size != 500 || worker.Storage != "fast" || !worker.ReadOnly || worker.SubPath != "images" {
+		t.Errorf("unexpected worker volume %+v", worker)
+	}
+	if cache := config.Services[1].Volumes[1]; cache.Size != 0 || cache.Storage != "" {
+		t.Errorf("expected private volume to be unchanged, got %+v", cache)
+	}
+
+	invalid := []Config{
+		{Volumes: []SharedVolume{{Name: "uploads"}, {Name: "uploads"}}},
+		{Volumes: []SharedVolume{{Name: "uploads", Size: -1}}},
+		{
+			Volumes:  []SharedVolume{{Name: "uploads"}},
+			Services: []Service{{Name: "web", Volumes: []Volume{{Name: "uploads", MountPath: "/data", Size: 10}}}},
+		},
+		{Services: []Service{{Name: "web", Volumes: []Volume{{Name: "data", MountPath: "/data", SubPath: "../etc"}}}}},
+		{Services: []Service{{Name: "web", Volumes: []Volume{{Name: "data", MountPath: "/data", SubPath: "/etc"}}}}},
+		{Services: []Service{{Name: "web", Volumes: []Volume{
+			{Name: "data", MountPath: "/data"},
+			{Name: "other", MountPath: "/data"},
+		}}}},
+	}
+	for _, config := range invalid {
+		if err := config.ResolveVolumes(); err == nil {
+			t.Errorf("expected %+v to be invalid", config)
+		}
+	}
+}
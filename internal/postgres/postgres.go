@@ -0,0 +1,193 @@
+// Package postgres contains functions for provisioning logical databases
+// inside postgres servers deployed by nimbus.
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"nimbus/internal/utils"
+
+	"github.com/jackc/pgx/v5"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	DefaultPort = 5432
+
+	maxIdentifierLength = 63
+	hashLength          = 8
+)
+
+var invalidIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Connection holds the details needed to connect to a postgres database.
+type Connection struct {
+	Host     string
+	Port     int
+	Database string
+	User     string
+	Password string
+}
+
+// AdminConnection builds the connection for the superuser of a postgres
+// template service from its environment, using the template defaults for
+// missing variables.
+func AdminConnection(host string, vars []corev1.EnvVar) Connection {
+	conn := Connection{
+		Host:     host,
+		Port:     DefaultPort,
+		Database: "postgres",
+		User:     "postgres",
+		Password: "postgres",
+	}
+	for _, v := range vars {
+		switch v.Name {
+		case "POSTGRES_USER":
+			conn.User = v.Value
+		case "POSTGRES_PASSWORD":
+			conn.Password = v.Value
+		case "POSTGRES_DB":
+			conn.Database = v.Value
+		}
+	}
+	return conn
+}
+
+// ConnectionFromValues parses a connection from the values produced by
+// Connection.Values.
+func ConnectionFromValues(values map[string]string) (Connection, error) {
+	port, err := strconv.Atoi(values["PGPORT"])
+	if err != nil {
+		return Connection{}, fmt.Errorf("parsing port: %w", err)
+	}
+	conn := Connection{
+		Host:     values["PGHOST"],
+		Port:     port,
+		Database: values["PGDATABASE"],
+		User:     values["PGUSER"],
+		Password: values["PGPASSWORD"],
+	}
+	if conn.Host == "" || conn.Database == "" || conn.User == "" {
+		return Connection{}, fmt.Errorf("incomplete connection values")
+	}
+	return conn, nil
+}
+
+// URL returns the connection as a postgres connection URL.
+func (c Connection) URL() string {
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(c.User, c.Password),
+		Host:   net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		Path:   c.Database,
+	}
+	return u.String()
+}
+
+// Values returns the connection as libpq environment variables along with
+// a DATABASE_URL.
+func (c Connection) Values() map[string]string {
+	return map[string]string{
+		"PGHOST":       c.Host,
+		"PGPORT":       strconv.Itoa(c.Port),
+		"PGDATABASE":   c.Database,
+		"PGUSER":       c.User,
+		"PGPASSWORD":   c.Password,
+		"DATABASE_URL": c.URL(),
+	}
+}
+
+// BranchIdentifier derives a valid postgres identifier for a branch from
+// the given base name. Identifiers longer than 63 characters are truncated
+// with a hash suffix of the full name, so long branches sharing a prefix
+// never get the same database.
+func BranchIdentifier(base, branch string) string {
+	full := fmt.Sprintf("%s_%s", base, branch)
+	name := invalidIdentifierChars.ReplaceAllString(strings.ToLower(full), "_")
+	if len(name) > maxIdentifierLength {
+		name = fmt.Sprintf("%s_%s",
+			strings.TrimRight(name[:maxIdentifierLength-hashLength-1], "_"), utils.HashSuffix(full))
+	}
+	return name
+}
+
+func connect(ctx context.Context, conn Connection) (*pgx.Conn, error) {
+	c, err := pgx.Connect(ctx, conn.URL())
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", conn.Host, err)
+	}
+	return c, nil
+}
+
+// CreateDatabase creates the role and database described by conn on the
+// server described by admin. Existing roles have their password reset so
+// that the returned connection is always valid.
+func CreateDatabase(ctx context.Context, admin, conn Connection) error {
+	c, err := connect(ctx, admin)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = c.Close(ctx) }()
+
+	role := pgx.Identifier{conn.User}.Sanitize()
+	password := strings.ReplaceAll(conn.Password, "'", "''")
+
+	var exists bool
+	err = c.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = $1)", conn.User).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("checking role existance: %w", err)
+	}
+	if exists {
+		_, err = c.Exec(ctx, fmt.Sprintf("ALTER ROLE %s WITH LOGIN PASSWORD '%s'", role, password))
+	} else {
+		_, err = c.Exec(ctx, fmt.Sprintf("CREATE ROLE %s WITH LOGIN PASSWORD '%s'", role, password))
+	}
+	if err != nil {
+		return fmt.Errorf("creating role: %w", err)
+	}
+
+	err = c.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = $1)", conn.Database).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("checking database existance: %w", err)
+	}
+	if exists {
+		return nil
+	}
+	_, err = c.Exec(ctx, fmt.Sprintf("CREATE DATABASE %s OWNER %s",
+		pgx.Identifier{conn.Database}.Sanitize(), role))
+	if err != nil {
+		return fmt.Errorf("creating database: %w", err)
+	}
+
+	return nil
+}
+
+// DropDatabase drops the database and role described by conn from the
+// server described by admin, terminating any open connections.
+func DropDatabase(ctx context.Context, admin, conn Connection) error {
+	c, err := connect(ctx, admin)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = c.Close(ctx) }()
+
+	_, err = c.Exec(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)",
+		pgx.Identifier{conn.Database}.Sanitize()))
+	if err != nil {
+		return fmt.Errorf("dropping database: %w", err)
+	}
+	_, err = c.Exec(ctx, fmt.Sprintf("DROP ROLE IF EXISTS %s", pgx.Identifier{conn.User}.Sanitize()))
+	if err != nil {
+		return fmt.Errorf("dropping role: %w", err)
+	}
+
+	return nil
+}
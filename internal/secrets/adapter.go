@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Adapter connects an external secret manager, such as Vault or a cloud
+// provider's secret manager. The secrets of a project branch are stored at
+// a path: "<project>/defaults" for the project defaults and
+// "<project>/branches/<branch>" for the overrides of a branch.
+type Adapter interface {
+	// Read returns the secrets stored at path, or no secrets when the path
+	// does not exist.
+	Read(ctx context.Context, path string) (map[string]string, error)
+	// Write replaces the secrets stored at path.
+	Write(ctx context.Context, path string, values map[string]string) error
+	// Delete removes the secrets stored at path. Deleting a missing path is
+	// not an error.
+	Delete(ctx context.Context, path string) error
+}
+
+// AdapterFactory creates an adapter on startup. Adapters read their own
+// configuration, such as addresses and credentials, from the environment.
+type AdapterFactory func(ctx context.Context) (Adapter, error)
+
+var (
+	adaptersMu sync.RWMutex
+	adapters   = map[string]AdapterFactory{}
+)
+
+// RegisterAdapter makes an adapter available as the backend of the given
+// name. It is meant to be called from the init function of the package
+// implementing the adapter and panics when the name is taken.
+func RegisterAdapter(name string, factory AdapterFactory) {
+	adaptersMu.Lock()
+	defer adaptersMu.Unlock()
+	if name == BackendPostgres || name == BackendFile || adapters[name] != nil {
+		panic(fmt.Sprintf("secrets: backend %s registered twice", name))
+	}
+	adapters[name] = factory
+}
+
+// AdapterStore stores secrets in an external secret manager.
+type AdapterStore struct {
+	adapter Adapter
+}
+
+func NewAdapterStore(adapter Adapter) *AdapterStore {
+	return &AdapterStore{adapter: adapter}
+}
+
+// OpenAdapter creates the adapter registered under name and wraps it in a
+// store.
+func OpenAdapter(ctx context.Context, name string) (*AdapterStore, error) {
+	adaptersMu.RLock()
+	factory, ok := adapters[name]
+	adaptersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown secrets backend %s", name)
+	}
+	adapter, err := factory(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating %s adapter: %w", name, err)
+	}
+	return NewAdapterStore(adapter), nil
+}
+
+// AdapterPath returns the path the secrets of a project branch are stored
+// at.
+func AdapterPath(project, branch string) string {
+	if branch == "" {
+		return fmt.Sprintf("%s/defaults", project)
+	}
+	return fmt.Sprintf("%s/branches/%s", project, branch)
+}
+
+func (s *AdapterStore) Get(ctx context.Context, project, branch string) (map[string]string, error) {
+	values, err := s.adapter.Read(ctx, AdapterPath(project, branch))
+	if err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = map[string]string{}
+	}
+	return values, nil
+}
+
+func (s *AdapterStore) Put(ctx context.Context, project, branch string, values map[string]string) error {
+	return s.adapter.Write(ctx, AdapterPath(project, branch), values)
+}
+
+func (s *AdapterStore) Delete(ctx context.Context, project, branch string) error {
+	return s.adapter.Delete(ctx, AdapterPath(project, branch))
+}
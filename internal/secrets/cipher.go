@@ -0,0 +1,120 @@
+// Package secrets contains the storage and encryption of project secrets.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the size in bytes of the key secrets are encrypted with.
+const KeySize = 32
+
+// wrappedKeySize is the size of an encrypted data key: the nonce, the key
+// and the authentication tag.
+const wrappedKeySize = 12 + KeySize + 16
+
+var ErrDecrypt = errors.New("decrypting secret failed")
+
+// Cipher encrypts secrets with envelope encryption. Every value is sealed
+// with a random data key, which is sealed with the key encryption key and
+// stored next to the value.
+type Cipher struct {
+	kek cipher.AEAD
+}
+
+// ParseKey decodes a base64 encoded key of KeySize bytes.
+func ParseKey(value string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("decoding key: %w", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("expected a key of %d bytes, got %d", KeySize, len(key))
+	}
+	return key, nil
+}
+
+// NewCipher returns a cipher using the base64 encoded key as key encryption
+// key.
+func NewCipher(value string) (*Cipher, error) {
+	key, err := ParseKey(value)
+	if err != nil {
+		return nil, err
+	}
+	kek, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{kek: kek}, nil
+}
+
+// Encrypt seals plaintext with a new data key.
+func (c *Cipher) Encrypt(plaintext []byte) ([]byte, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("generating data key: %w", err)
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := seal(c.kek, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := seal(dek, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return append(wrapped, sealed...), nil
+}
+
+// Decrypt opens a value sealed by Encrypt.
+func (c *Cipher) Decrypt(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < wrappedKeySize {
+		return nil, ErrDecrypt
+	}
+	dataKey, err := open(c.kek, ciphertext[:wrappedKeySize])
+	if err != nil {
+		return nil, err
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(dek, ciphertext[wrappedKeySize:])
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext and prepends the random nonce.
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
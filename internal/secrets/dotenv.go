@@ -0,0 +1,157 @@
+package secrets
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	dotenvKeyRegex  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+	dotenvBareRegex = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,=-]+$`)
+)
+
+// ParseDotenv parses secrets in the .env format. Lines hold KEY=value pairs,
+// optionally prefixed with export, and lines starting with # are comments.
+// Unquoted values end at the first # preceded by whitespace. Single quoted
+// values are taken literally, double quoted values support the escapes \n,
+// \r, \t, \", \$ and \\. Quoted values may span lines.
+func ParseDotenv(data string) (map[string]string, error) {
+	values := map[string]string{}
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found {
+			return nil, fmt.Errorf("line %d: expected KEY=value", lineNumber)
+		}
+		if !dotenvKeyRegex.MatchString(key) {
+			return nil, fmt.Errorf("line %d: invalid key %q", lineNumber, key)
+		}
+		value = strings.TrimLeft(value, " \t")
+
+		if value == "" || (value[0] != '"' && value[0] != '\'') {
+			if idx := strings.Index(value, " #"); idx >= 0 {
+				value = value[:idx]
+			}
+			if idx := strings.Index(value, "\t#"); idx >= 0 {
+				value = value[:idx]
+			}
+			values[key] = strings.TrimSpace(value)
+			continue
+		}
+
+		// Quoted values continue on the following lines until the closing quote
+		quote := value[0]
+		rest := value[1:]
+		var sb strings.Builder
+		for {
+			end := closingQuote(rest, quote)
+			if end >= 0 {
+				sb.WriteString(rest[:end])
+				trailing := strings.TrimSpace(rest[end+1:])
+				if trailing != "" && !strings.HasPrefix(trailing, "#") {
+					return nil, fmt.Errorf("line %d: unexpected %q after closing quote", i+1, trailing)
+				}
+				break
+			}
+			sb.WriteString(rest)
+			i++
+			if i >= len(lines) {
+				return nil, fmt.Errorf("line %d: missing closing quote", lineNumber)
+			}
+			sb.WriteString("\n")
+			rest = lines[i]
+		}
+
+		if quote == '\'' {
+			values[key] = sb.String()
+			continue
+		}
+		values[key] = unescapeDotenv(sb.String())
+	}
+	return values, nil
+}
+
+// closingQuote returns the index of the unescaped closing quote in s, or -1.
+func closingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		if quote == '"' && s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] == quote {
+			return i
+		}
+	}
+	return -1
+}
+
+func unescapeDotenv(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case '"', '\\', '$':
+			sb.WriteByte(s[i])
+		default:
+			sb.WriteByte('\\')
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
+
+// FormatDotenv writes secrets in the .env format read by ParseDotenv, one
+// line per key sorted by name. Values are double quoted unless they only
+// contain characters which never need quoting.
+func FormatDotenv(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		sb.WriteString(key)
+		sb.WriteString("=")
+		sb.WriteString(QuoteDotenv(values[key]))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// QuoteDotenv returns a value as written by FormatDotenv.
+func QuoteDotenv(value string) string {
+	if dotenvBareRegex.MatchString(value) {
+		return value
+	}
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		`$`, `\$`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	)
+	return `"` + replacer.Replace(value) + `"`
+}
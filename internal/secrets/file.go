@@ -0,0 +1,107 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileStore keeps secrets unencrypted in a JSON file keyed by project and
+// branch. It is meant for local development only.
+type FileStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+type fileContents map[string]map[string]map[string]string
+
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+func (s *FileStore) Get(_ context.Context, project, branch string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contents, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	values := maps.Clone(contents[project][branch])
+	if values == nil {
+		values = map[string]string{}
+	}
+	return values, nil
+}
+
+func (s *FileStore) Put(_ context.Context, project, branch string, values map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contents, err := s.read()
+	if err != nil {
+		return err
+	}
+	if contents[project] == nil {
+		contents[project] = map[string]map[string]string{}
+	}
+	contents[project][branch] = maps.Clone(values)
+	return s.write(contents)
+}
+
+func (s *FileStore) Delete(_ context.Context, project, branch string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contents, err := s.read()
+	if err != nil {
+		return err
+	}
+	delete(contents[project], branch)
+	if len(contents[project]) == 0 {
+		delete(contents, project)
+	}
+	return s.write(contents)
+}
+
+func (s *FileStore) read() (fileContents, error) {
+	contents := fileContents{}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return contents, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading secrets file: %w", err)
+	}
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("parsing secrets file: %w", err)
+	}
+	return contents, nil
+}
+
+// write replaces the file atomically, so a crash never leaves it truncated.
+func (s *FileStore) write(contents fileContents) error {
+	data, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling secrets: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".secrets-*.tmp")
+	if err != nil {
+		return fmt.Errorf("creating secrets file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("writing secrets file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing secrets file: %w", err)
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
@@ -0,0 +1,55 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"nimbus/internal/database"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// PostgresStore keeps secrets in the nimbus database, sealed with envelope
+// encryption so a database dump does not reveal them.
+type PostgresStore struct {
+	db     database.Querier
+	cipher *Cipher
+}
+
+func NewPostgresStore(db database.Querier, cipher *Cipher) *PostgresStore {
+	return &PostgresStore{db: db, cipher: cipher}
+}
+
+func (s *PostgresStore) Get(ctx context.Context, project, branch string) (map[string]string, error) {
+	data, err := s.db.GetProjectSecrets(ctx, database.GetProjectSecretsParams{
+		ProjectName:   project,
+		ProjectBranch: branch,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting secrets: %w", err)
+	}
+	return s.cipher.DecryptValues(data)
+}
+
+func (s *PostgresStore) Put(ctx context.Context, project, branch string, values map[string]string) error {
+	data, err := s.cipher.EncryptValues(values)
+	if err != nil {
+		return fmt.Errorf("encrypting secrets: %w", err)
+	}
+	return s.db.UpsertProjectSecrets(ctx, database.UpsertProjectSecretsParams{
+		ProjectBranch: branch,
+		Data:          data,
+		ProjectName:   project,
+	})
+}
+
+func (s *PostgresStore) Delete(ctx context.Context, project, branch string) error {
+	return s.db.DeleteProjectSecrets(ctx, database.DeleteProjectSecretsParams{
+		ProjectBranch: branch,
+		ProjectName:   project,
+	})
+}
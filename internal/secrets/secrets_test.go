@@ -0,0 +1,160 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"maps"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func newTestCipher(t *testing.T) *Cipher {
+	t.Helper()
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c, err := NewCipher(base64.StdEncoding.EncodeToString(key))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return c
+}
+
+func TestCipher(t *testing.T) {
+	c := newTestCipher(t)
+
+	plaintext := []byte("API_KEY=s3cret")
+	first, err := c.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := c.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bytes.Contains(first, plaintext) || bytes.Equal(first, second) {
+		t.Error("expected ciphertexts to hide the plaintext and differ")
+	}
+
+	decrypted, err := c.Decrypt(first)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("expected %q, got %q", plaintext, decrypted)
+	}
+
+	first[len(first)-1] ^= 1
+	if _, err := c.Decrypt(first); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("expected ErrDecrypt for tampered ciphertext, got %v", err)
+	}
+	if _, err := newTestCipher(t).Decrypt(second); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("expected ErrDecrypt for another key, got %v", err)
+	}
+
+	for _, key := range []string{"", "not base64", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		if _, err := NewCipher(key); err == nil {
+			t.Errorf("expected error for key %q", key)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := map[string]string{"API_KEY": "a", "DATABASE_URL": "postgres://", "OLD": "x"}
+	after := map[string]string{"API_KEY": "b", "DATABASE_URL": "postgres://", "NEW": "y"}
+
+	changes := Diff(before, after)
+	if !slices.Equal(changes.Added, []string{"NEW"}) ||
+		!slices.Equal(changes.Removed, []string{"OLD"}) ||
+		!slices.Equal(changes.Changed, []string{"API_KEY"}) {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+	if !Diff(after, after).Empty() {
+		t.Error("expected no changes between equal versions")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+	store := NewFileStore(filepath.Join(t.TempDir(), "secrets.json"))
+
+	if err := store.Put(ctx, "shop", "", map[string]string{"API_KEY": "live", "REGION": "eu"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := store.Put(ctx, "shop", "staging", map[string]string{"API_KEY": "test"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	values, sources, err := Resolve(ctx, store, "shop", "staging")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !maps.Equal(values, map[string]string{"API_KEY": "test", "REGION": "eu"}) {
+		t.Errorf("unexpected values %v", values)
+	}
+	if !maps.Equal(sources, map[string]string{"API_KEY": SourceOverride, "REGION": SourceDefault}) {
+		t.Errorf("unexpected sources %v", sources)
+	}
+
+	if err := store.Delete(ctx, "shop", "staging"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	values, _, err = Resolve(ctx, store, "shop", "staging")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if values["API_KEY"] != "live" {
+		t.Errorf("expected the default after deleting the override, got %q", values["API_KEY"])
+	}
+
+	other, err := store.Get(ctx, "blog", "")
+	if err != nil || len(other) != 0 {
+		t.Errorf("expected no secrets for an unknown project, got %v, %v", other, err)
+	}
+}
+
+func TestDotenv(t *testing.T) {
+	data := `# comment
+export PLAIN=value # trailing comment
+EMPTY=
+HASH=a#b
+SINGLE='literal \n $HOME'
+DOUBLE="line\nbreak \"quoted\" \$HOME"
+MULTI="first
+second"
+`
+	values, err := ParseDotenv(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"PLAIN":  "value",
+		"EMPTY":  "",
+		"HASH":   "a#b",
+		"SINGLE": `literal \n $HOME`,
+		"DOUBLE": "line\nbreak \"quoted\" $HOME",
+		"MULTI":  "first\nsecond",
+	}
+	if !maps.Equal(values, want) {
+		t.Errorf("expected %q, got %q", want, values)
+	}
+
+	roundtrip, err := ParseDotenv(FormatDotenv(want))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !maps.Equal(roundtrip, want) {
+		t.Errorf("expected %q after formatting, got %q", want, roundtrip)
+	}
+
+	for _, invalid := range []string{"NO_EQUALS", "1KEY=value", `OPEN="never closed`, `KEY="a" b`} {
+		if _, err := ParseDotenv(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
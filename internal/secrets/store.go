@@ -0,0 +1,89 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+const (
+	// BackendPostgres stores secrets encrypted in the nimbus database.
+	BackendPostgres = "postgres"
+	// BackendFile stores secrets in a local file, for development.
+	BackendFile = "file"
+)
+
+// PreviewAuthBranch is the branch the basic auth credentials protecting the
+// previews of a project are stored under. Git branch names cannot contain a
+// colon, so it never clashes with the secrets of a real branch.
+const PreviewAuthBranch = ":preview-auth"
+
+const (
+	// SourceDefault marks a secret inherited from the project defaults.
+	SourceDefault = "default"
+	// SourceOverride marks a secret overridden by the branch.
+	SourceOverride = "override"
+)
+
+// Store is the source of truth of project secrets. Each project has
+// defaults, stored for the empty branch, which every branch inherits, and
+// overrides per branch.
+type Store interface {
+	// Get returns the secrets of a project branch, or no secrets when none
+	// are stored.
+	Get(ctx context.Context, project, branch string) (map[string]string, error)
+	// Put replaces the secrets of a project branch.
+	Put(ctx context.Context, project, branch string, values map[string]string) error
+	// Delete removes the secrets of a project branch.
+	Delete(ctx context.Context, project, branch string) error
+}
+
+// Backends returns the names of the available backends, the built-in ones
+// followed by the registered adapters.
+func Backends() []string {
+	adaptersMu.RLock()
+	defer adaptersMu.RUnlock()
+	backends := []string{BackendPostgres, BackendFile}
+	adapterNames := make([]string, 0, len(adapters))
+	for name := range adapters {
+		adapterNames = append(adapterNames, name)
+	}
+	sort.Strings(adapterNames)
+	return append(backends, adapterNames...)
+}
+
+// IsBackend reports whether a backend of the given name is available.
+func IsBackend(name string) bool {
+	if name == BackendPostgres || name == BackendFile {
+		return true
+	}
+	adaptersMu.RLock()
+	defer adaptersMu.RUnlock()
+	_, ok := adapters[name]
+	return ok
+}
+
+// Resolve returns the secrets of a branch, the project defaults with the
+// branch overrides applied, and whether each key is inherited or overridden.
+func Resolve(ctx context.Context, store Store, project, branch string) (map[string]string, map[string]string, error) {
+	defaults, err := store.Get(ctx, project, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting default secrets: %w", err)
+	}
+	overrides, err := store.Get(ctx, project, branch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting override secrets: %w", err)
+	}
+
+	values := make(map[string]string, len(defaults)+len(overrides))
+	sources := make(map[string]string, len(defaults)+len(overrides))
+	for k, v := range defaults {
+		values[k] = v
+		sources[k] = SourceDefault
+	}
+	for k, v := range overrides {
+		values[k] = v
+		sources[k] = SourceOverride
+	}
+	return values, sources, nil
+}
@@ -0,0 +1,66 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// EncryptValues seals a map of secret values.
+func (c *Cipher) EncryptValues(values map[string]string) ([]byte, error) {
+	plaintext, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling values: %w", err)
+	}
+	return c.Encrypt(plaintext)
+}
+
+// DecryptValues opens a map of secret values sealed by EncryptValues.
+func (c *Cipher) DecryptValues(ciphertext []byte) (map[string]string, error) {
+	plaintext, err := c.Decrypt(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	if err := json.Unmarshal(plaintext, &values); err != nil {
+		return nil, fmt.Errorf("unmarshaling values: %w", err)
+	}
+	return values, nil
+}
+
+// Changes lists the keys added, removed and changed between two versions of
+// a secret map, sorted by name.
+type Changes struct {
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+// Empty reports whether the versions are equal.
+func (c Changes) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0
+}
+
+// Diff compares two versions of a secret map by key without exposing the
+// values.
+func Diff(before, after map[string]string) Changes {
+	changes := Changes{Added: []string{}, Removed: []string{}, Changed: []string{}}
+	for key, value := range after {
+		previous, ok := before[key]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, key)
+		case previous != value:
+			changes.Changed = append(changes.Changed, key)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changes.Removed = append(changes.Removed, key)
+		}
+	}
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Removed)
+	sort.Strings(changes.Changed)
+	return changes
+}
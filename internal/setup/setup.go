@@ -4,10 +4,16 @@ package setup
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strconv"
+	"time"
 
 	"nimbus/internal/config"
 	"nimbus/internal/database"
+	"nimbus/internal/env"
+	"nimbus/internal/kubernetes"
+	"nimbus/internal/secrets"
+	"nimbus/internal/utils"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -44,3 +50,174 @@ func Database(ctx context.Context, config config.Config) (*database.Database, er
 
 	return db, nil
 }
+
+// ReapVolumes deletes the unused volumes whose grace period has ended every
+// interval until the context is cancelled.
+func ReapVolumes(ctx context.Context, env *env.Env, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		err := kubernetes.ReapExpiredVolumes(ctx, env)
+		if err != nil {
+			env.Logger.ErrorContext(ctx, "failed to reap expired volumes", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SecretStore opens the configured secret storage backend.
+func SecretStore(ctx context.Context, config config.Config, db database.Querier) (secrets.Store, error) {
+	switch config.SecretsBackend {
+	case secrets.BackendPostgres:
+		cipher, err := secrets.NewCipher(config.SecretsKey)
+		if err != nil {
+			return nil, fmt.Errorf("creating cipher: %w", err)
+		}
+		return secrets.NewPostgresStore(db, cipher), nil
+	case secrets.BackendFile:
+		return secrets.NewFileStore(config.SecretsFile), nil
+	default:
+		return secrets.OpenAdapter(ctx, config.SecretsBackend)
+	}
+}
+
+// MigrateSecrets moves the secrets kept in kubernetes before the secret
+// store existed into the store. Each project defaults and branch overrides
+// are imported once, recorded as their first version, and the legacy
+// kubernetes secrets are deleted afterwards. Preview credentials kept in the
+// main namespace are moved into the store the same way.
+func MigrateSecrets(ctx context.Context, env *env.Env) error {
+	projects, err := env.Database.GetProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("getting projects: %w", err)
+	}
+	cipher, err := secrets.NewCipher(env.Config.SecretsKey)
+	if err != nil {
+		return fmt.Errorf("creating cipher: %w", err)
+	}
+
+	for _, project := range projects {
+		mainNS := utils.GetSanitizedNamespace(project.Name, "main")
+		err = migrateSecrets(ctx, project, "", mainNS, fmt.Sprintf("%s-env-defaults", project.Name), cipher, env)
+		if err != nil {
+			return fmt.Errorf("migrating defaults of project %s: %w", project.Name, err)
+		}
+
+		err = migratePreviewAuth(ctx, project, mainNS, env)
+		if err != nil {
+			return fmt.Errorf("migrating preview credentials of project %s: %w", project.Name, err)
+		}
+
+		branches, err := env.Database.GetProjectBranches(ctx, project.ID)
+		if err != nil {
+			return fmt.Errorf("getting branches of project %s: %w", project.Name, err)
+		}
+		for _, branch := range branches {
+			namespace := utils.GetSanitizedNamespace(project.Name, branch)
+			err = migrateSecrets(ctx, project, branch, namespace, fmt.Sprintf("%s-env-overrides", project.Name), cipher, env)
+			if err != nil {
+				return fmt.Errorf("migrating overrides of project %s branch %s: %w", project.Name, branch, err)
+			}
+		}
+	}
+	return nil
+}
+
+func migrateSecrets(
+	ctx context.Context, project database.Project, branch, namespace, name string,
+	cipher *secrets.Cipher, env *env.Env,
+) error {
+	// Secrets in the store are managed by it already. History alone doesn't
+	// tell, versions were recorded while kubernetes held the secrets.
+	stored, err := env.Secrets.Get(ctx, project.Name, branch)
+	if err != nil {
+		return fmt.Errorf("getting stored secrets: %w", err)
+	}
+	if len(stored) > 0 {
+		return nil
+	}
+
+	values, err := kubernetes.GetSecretData(ctx, namespace, name, env)
+	if err != nil {
+		return fmt.Errorf("getting secret %s: %w", name, err)
+	}
+	// Projects without defaults shared the secrets of the main branch. Once
+	// the store recorded defaults, that secret only holds a synced copy.
+	recorded, err := env.Database.HasSecretVersions(ctx, database.HasSecretVersionsParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if err != nil {
+		return fmt.Errorf("checking secret history: %w", err)
+	}
+	if branch == "" && len(values) == 0 && !recorded {
+		values, err = kubernetes.GetSecretData(ctx, namespace, kubernetes.EnvSecretName(project.Name), env)
+		if err != nil {
+			return fmt.Errorf("getting secret %s: %w", kubernetes.EnvSecretName(project.Name), err)
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	env.Logger.InfoContext(ctx, "migrating secrets",
+		slog.String("project", project.Name),
+		slog.String("branch", branch))
+	err = env.Secrets.Put(ctx, project.Name, branch, values)
+	if err != nil {
+		return fmt.Errorf("storing secrets: %w", err)
+	}
+	data, err := cipher.EncryptValues(values)
+	if err != nil {
+		return fmt.Errorf("encrypting secrets: %w", err)
+	}
+	latest, err := env.Database.GetLatestSecretVersion(ctx, database.GetLatestSecretVersionParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+	})
+	if err != nil {
+		return fmt.Errorf("getting latest secret version: %w", err)
+	}
+	changes := secrets.Diff(map[string]string{}, values)
+	_, err = env.Database.CreateSecretVersion(ctx, database.CreateSecretVersionParams{
+		ProjectID:     project.ID,
+		ProjectBranch: branch,
+		Version:       latest + 1,
+		Data:          data,
+		Added:         changes.Added,
+		Removed:       changes.Removed,
+		Changed:       changes.Changed,
+	})
+	if err != nil {
+		return fmt.Errorf("creating secret version: %w", err)
+	}
+	return kubernetes.DeleteSecret(ctx, namespace, name, env)
+}
+
+func migratePreviewAuth(ctx context.Context, project database.Project, namespace string, env *env.Env) error {
+	values, err := kubernetes.GetSecretData(ctx, namespace, kubernetes.PreviewAuthSecret, env)
+	if err != nil {
+		return fmt.Errorf("getting secret %s: %w", kubernetes.PreviewAuthSecret, err)
+	}
+	if values["auth"] == "" {
+		return nil
+	}
+
+	stored, err := env.Secrets.Get(ctx, project.Name, secrets.PreviewAuthBranch)
+	if err != nil {
+		return fmt.Errorf("getting preview credentials: %w", err)
+	}
+	if len(stored) == 0 {
+		env.Logger.InfoContext(ctx, "migrating preview credentials", slog.String("project", project.Name))
+		err = env.Secrets.Put(ctx, project.Name, secrets.PreviewAuthBranch, map[string]string{"auth": values["auth"]})
+		if err != nil {
+			return fmt.Errorf("storing preview credentials: %w", err)
+		}
+	}
+	return kubernetes.DeleteSecret(ctx, namespace, kubernetes.PreviewAuthSecret, env)
+}
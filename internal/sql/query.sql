@@ -83,9 +83,50 @@ WHERE
   AND project_id = $2
   AND project_branch = $3;
 
+-- name: GetVolume :one
+SELECT
+  *
+FROM
+  volumes
+WHERE
+  volume_name = $1
+  AND project_id = $2
+  AND project_branch = $3;
+
+-- name: GetVolumesByBranch :many
+SELECT
+  *
+FROM
+  volumes
+WHERE
+  project_id = $1
+  AND project_branch = $2
+ORDER BY
+  volume_name;
+
+-- name: DeleteVolume :exec
+DELETE FROM volumes
+WHERE identifier = $1;
+
+-- name: SetVolumeSize :exec
+UPDATE
+  volumes
+SET
+  size = $2
+WHERE
+  identifier = $1;
+
+-- name: SetVolumeDeleteAfter :exec
+UPDATE
+  volumes
+SET
+  delete_after = $2
+WHERE
+  identifier = $1;
+
 -- name: CreateVolume :one
-INSERT INTO volumes (identifier, volume_name, project_id, project_branch, size)
-  VALUES ($1, $2, $3, $4, $5)
+INSERT INTO volumes (identifier, volume_name, project_id, project_branch, size, storage)
+  VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING
   *;
 
@@ -99,6 +140,30 @@ WHERE
   AND project_branch = $2
   AND NOT volume_name = ANY (@exclude_volumes::text[]);
 
+-- name: GetExpiredVolumes :many
+SELECT
+  *
+FROM
+  volumes
+WHERE
+  delete_after <= now()
+ORDER BY
+  project_id,
+  project_branch,
+  volume_name;
+
+-- name: GetUnusedVolumes :many
+SELECT
+  *
+FROM
+  volumes
+WHERE
+  project_id = $1
+  AND project_branch = $2
+  AND NOT volume_name = ANY (@exclude_volumes::text[])
+ORDER BY
+  volume_name;
+
 -- name: GetUserByApiKey :one
 SELECT
   *
@@ -127,6 +192,14 @@ WHERE
   name = $1
 LIMIT 1;
 
+-- name: GetProjects :many
+SELECT
+  *
+FROM
+  projects
+ORDER BY
+  name;
+
 -- name: GetProjectById :one
 SELECT
   id,
@@ -208,13 +281,273 @@ FROM
 WHERE
   v.project_id = $1;
 
--- name: CheckProjectsTableExists :one
+-- name: GetDomain :one
+SELECT
+  *
+FROM
+  domains
+WHERE
+  domain = $1
+LIMIT 1;
+
+-- name: UpsertDomain :execrows
+INSERT INTO domains (domain, project_id, service_name)
+  VALUES ($1, $2, $3)
+ON CONFLICT (domain)
+  DO UPDATE SET
+    service_name = EXCLUDED.service_name
+  WHERE
+    domains.project_id = EXCLUDED.project_id;
+
+-- name: DeleteUnusedDomains :exec
+DELETE FROM domains
+WHERE project_id = $1
+  AND NOT domain = ANY (@keep_domains::text[]);
+
+-- name: GetServiceByIngress :one
+SELECT
+  *
+FROM
+  services
+WHERE
+  ingress = $1
+LIMIT 1;
+
+-- name: GetNodePort :one
+SELECT
+  *
+FROM
+  node_ports
+WHERE
+  node_port = $1
+LIMIT 1;
+
+-- name: GetNodePortsByService :many
+SELECT
+  *
+FROM
+  node_ports
+WHERE
+  project_id = $1
+  AND project_branch = $2
+  AND service_name = $3
+ORDER BY
+  port;
+
+-- name: GetAllocatedNodePorts :many
+SELECT
+  node_port
+FROM
+  node_ports
+ORDER BY
+  node_port;
+
+-- name: ReserveNodePort :exec
+INSERT INTO node_ports (node_port, project_id, project_branch, service_name, port, protocol)
+  VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (project_id, project_branch, service_name, port, protocol)
+  DO UPDATE SET
+    node_port = EXCLUDED.node_port;
+
+-- name: ReleaseUnusedNodePorts :exec
+DELETE FROM node_ports
+WHERE project_id = $1
+  AND project_branch = $2
+  AND service_name = $3
+  AND NOT (port::text || '/' || protocol) = ANY (@keep_ports::text[]);
+
+-- name: ReleaseBranchNodePorts :exec
+DELETE FROM node_ports
+WHERE project_id = $1
+  AND project_branch = $2;
+
+-- name: GetPreviewProtection :one
+SELECT
+  *
+FROM
+  preview_protections
+WHERE
+  project_id = $1
+LIMIT 1;
+
+-- name: UpsertPreviewProtection :exec
+INSERT INTO preview_protections (project_id, mode, allowlist)
+  VALUES ($1, $2, $3)
+ON CONFLICT (project_id)
+  DO UPDATE SET
+    mode = EXCLUDED.mode,
+    allowlist = EXCLUDED.allowlist;
+
+-- name: DeletePreviewProtection :exec
+DELETE FROM preview_protections
+WHERE project_id = $1;
+
+-- name: GetCertificate :one
+SELECT
+  *
+FROM
+  certificates
+WHERE
+  domain = $1
+LIMIT 1;
+
+-- name: GetCertificatesByProject :many
+SELECT
+  *
+FROM
+  certificates
+WHERE
+  project_id = $1
+ORDER BY
+  domain;
+
+-- name: UpsertCertificate :execrows
+INSERT INTO certificates (domain, project_id, secret_name, not_after)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (domain)
+  DO UPDATE SET
+    secret_name = EXCLUDED.secret_name,
+    not_after = EXCLUDED.not_after
+  WHERE
+    certificates.project_id = EXCLUDED.project_id;
+
+-- name: CreateVolumeSnapshot :one
+INSERT INTO volume_snapshots (id, volume_identifier, project_id, method, size)
+  VALUES ($1, $2, $3, $4, $5)
+RETURNING
+  *;
+
+-- name: GetVolumeSnapshot :one
+SELECT
+  s.*,
+  v.volume_name,
+  v.project_branch
+FROM
+  volume_snapshots s
+  JOIN volumes v ON s.volume_identifier = v.identifier
+WHERE
+  s.id = $1
+  AND s.project_id = $2;
+
+-- name: GetVolumeSnapshotsByProject :many
+SELECT
+  s.*,
+  v.volume_name,
+  v.project_branch
+FROM
+  volume_snapshots s
+  JOIN volumes v ON s.volume_identifier = v.identifier
+WHERE
+  s.project_id = $1
+ORDER BY
+  s.created_at DESC;
+
+-- name: GetVolumeSnapshotsByVolume :many
+SELECT
+  *
+FROM
+  volume_snapshots
+WHERE
+  volume_identifier = $1;
+
+-- name: CreateSecretVersion :one
+INSERT INTO secret_versions (project_id, project_branch, version, data, user_id, added, removed, changed)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING
+  *;
+
+-- name: GetSecretVersion :one
+SELECT
+  *
+FROM
+  secret_versions
+WHERE
+  project_id = $1
+  AND project_branch = $2
+  AND version = $3;
+
+-- name: GetSecretVersions :many
+SELECT
+  s.project_id,
+  s.project_branch,
+  s.version,
+  s.user_id,
+  s.added,
+  s.removed,
+  s.changed,
+  s.created_at,
+  u.username
+FROM
+  secret_versions s
+  LEFT JOIN users u ON s.user_id = u.id
+WHERE
+  s.project_id = $1
+  AND s.project_branch = $2
+ORDER BY
+  s.version DESC;
+
+-- name: GetLatestSecretVersion :one
+SELECT
+  COALESCE(MAX(version), 0)::integer
+FROM
+  secret_versions
+WHERE
+  project_id = $1
+  AND project_branch = $2;
+
+-- name: HasSecretVersions :one
 SELECT
   EXISTS (
     SELECT
       1
     FROM
-      information_schema.tables
+      secret_versions
+    WHERE
+      project_id = $1
+      AND project_branch = $2);
+
+-- name: DeleteSecretVersion :exec
+DELETE FROM secret_versions
+WHERE project_id = $1
+  AND project_branch = $2
+  AND version = $3;
+
+-- name: DeleteSecretVersionsByBranch :exec
+DELETE FROM secret_versions
+WHERE project_id = $1
+  AND project_branch = $2;
+
+-- name: GetProjectSecrets :one
+SELECT
+  s.data
+FROM
+  project_secrets s
+  JOIN projects p ON s.project_id = p.id
+WHERE
+  p.name = @project_name
+  AND s.project_branch = @project_branch;
+
+-- name: UpsertProjectSecrets :exec
+INSERT INTO project_secrets (project_id, project_branch, data)
+SELECT
+  id,
+  @project_branch,
+  @data
+FROM
+  projects
+WHERE
+  name = @project_name
+ON CONFLICT (project_id, project_branch)
+  DO UPDATE SET
+    data = EXCLUDED.data, updated_at = now();
+
+-- name: DeleteProjectSecrets :exec
+DELETE FROM project_secrets
+WHERE project_branch = @project_branch
+  AND project_id = (
+    SELECT
+      id
+    FROM
+      projects
     WHERE
-      table_schema = 'public'
-      AND table_name = 'projects');
+      name = @project_name);
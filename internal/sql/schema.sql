@@ -1,9 +1,9 @@
-CREATE TABLE projects (
+CREATE TABLE IF NOT EXISTS projects (
   id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
   name text NOT NULL
 );
 
-CREATE TABLE services (
+CREATE TABLE IF NOT EXISTS services (
   id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
   project_id uuid NOT NULL,
   project_branch text NOT NULL,
@@ -14,7 +14,7 @@ CREATE TABLE services (
   UNIQUE (project_id, project_branch, service_name)
 );
 
-CREATE TABLE volumes (
+CREATE TABLE IF NOT EXISTS volumes (
   identifier uuid PRIMARY KEY,
   volume_name text NOT NULL,
   project_id uuid NOT NULL,
@@ -23,16 +23,90 @@ CREATE TABLE volumes (
   FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
 );
 
-CREATE TABLE users (
+ALTER TABLE volumes
+  ADD COLUMN IF NOT EXISTS storage text NOT NULL DEFAULT '';
+
+ALTER TABLE volumes
+  ADD COLUMN IF NOT EXISTS delete_after timestamptz;
+
+CREATE TABLE IF NOT EXISTS users (
   id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
   username text NOT NULL,
   api_key text NOT NULL
 );
 
-CREATE TABLE user_projects (
+CREATE TABLE IF NOT EXISTS user_projects (
   user_id uuid NOT NULL,
   project_id uuid NOT NULL,
   PRIMARY KEY (user_id, project_id),
   FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
   FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
 );
+
+CREATE TABLE IF NOT EXISTS domains (
+  domain text PRIMARY KEY,
+  project_id uuid NOT NULL,
+  service_name text NOT NULL,
+  FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS node_ports (
+  node_port integer PRIMARY KEY,
+  project_id uuid NOT NULL,
+  project_branch text NOT NULL,
+  service_name text NOT NULL,
+  port integer NOT NULL,
+  protocol text NOT NULL DEFAULT 'TCP',
+  FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  UNIQUE (project_id, project_branch, service_name, port, protocol)
+);
+
+CREATE TABLE IF NOT EXISTS preview_protections (
+  project_id uuid PRIMARY KEY,
+  mode text NOT NULL,
+  allowlist text[] NOT NULL DEFAULT '{}',
+  FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS certificates (
+  domain text PRIMARY KEY,
+  project_id uuid NOT NULL,
+  secret_name text NOT NULL,
+  not_after timestamptz NOT NULL,
+  FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS volume_snapshots (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid (),
+  volume_identifier uuid NOT NULL,
+  project_id uuid NOT NULL,
+  method text NOT NULL,
+  size integer NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  FOREIGN KEY (volume_identifier) REFERENCES volumes (identifier) ON DELETE CASCADE,
+  FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS secret_versions (
+  project_id uuid NOT NULL,
+  project_branch text NOT NULL,
+  version integer NOT NULL,
+  data bytea NOT NULL,
+  user_id uuid,
+  added text[] NOT NULL DEFAULT '{}',
+  removed text[] NOT NULL DEFAULT '{}',
+  changed text[] NOT NULL DEFAULT '{}',
+  created_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (project_id, project_branch, version),
+  FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS project_secrets (
+  project_id uuid NOT NULL,
+  project_branch text NOT NULL,
+  data bytea NOT NULL,
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (project_id, project_branch),
+  FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ParseCertificate checks that a PEM encoded certificate and key belong
+// together, that the certificate covers domain and that it has not expired.
+// It returns the leaf certificate.
+func ParseCertificate(certPEM, keyPEM []byte, domain string) (*x509.Certificate, error) {
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("certificate and key do not match: %w", err)
+	}
+	if len(pair.Certificate) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %w", err)
+	}
+	if err := leaf.VerifyHostname(domain); err != nil {
+		return nil, fmt.Errorf("certificate does not cover %s", domain)
+	}
+	if time.Now().After(leaf.NotAfter) {
+		return nil, fmt.Errorf("certificate expired on %s", leaf.NotAfter.Format(time.DateOnly))
+	}
+	return leaf, nil
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func generateCertificate(t *testing.T, domain string, notAfter time.Time) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain},
+		NotBefore:    notAfter.Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestParseCertificate(t *testing.T) {
+	valid := time.Now().Add(24 * time.Hour)
+	cert, key := generateCertificate(t, "app.example.com", valid)
+	_, otherKey := generateCertificate(t, "app.example.com", valid)
+	expiredCert, expiredKey := generateCertificate(t, "app.example.com", time.Now().Add(-time.Hour))
+
+	leaf, err := ParseCertificate(cert, key, "app.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !leaf.NotAfter.Equal(valid.Truncate(time.Second)) {
+		t.Errorf("expected expiry %s, got %s", valid, leaf.NotAfter)
+	}
+
+	if _, err := ParseCertificate(cert, otherKey, "app.example.com"); err == nil {
+		t.Error("expected error for mismatched key")
+	}
+	if _, err := ParseCertificate(cert, key, "other.example.com"); err == nil {
+		t.Error("expected error for uncovered domain")
+	}
+	if _, err := ParseCertificate(expiredCert, expiredKey, "app.example.com"); err == nil {
+		t.Error("expected error for expired certificate")
+	}
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxLabelLength = 63
+	hashLength     = 8
+)
+
+var (
+	hostPlaceholder  = regexp.MustCompile(`\{[^}]*\}`)
+	invalidLabelChar = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// ValidateHostPattern checks that a host pattern only uses known placeholders
+// and stays under the configured domain.
+func ValidateHostPattern(pattern string) error {
+	for _, placeholder := range hostPlaceholder.FindAllString(pattern, -1) {
+		switch placeholder {
+		case "{service}", "{branch}", "{project}", "{domain}":
+		default:
+			return fmt.Errorf("unknown placeholder %s", placeholder)
+		}
+	}
+	if !strings.HasSuffix(pattern, ".{domain}") || strings.Count(pattern, "{domain}") != 1 {
+		return fmt.Errorf("pattern must end with .{domain}")
+	}
+	return nil
+}
+
+// FormatHost fills a host pattern such as {service}-{branch}-{project}.{domain}.
+// Values are sanitized into DNS labels and labels that are too long are
+// truncated with a hash suffix so distinct inputs stay distinct.
+func FormatHost(pattern, service, branch, project, domain string) string {
+	replacer := strings.NewReplacer(
+		"{service}", SanitizeLabel(service),
+		"{branch}", SanitizeLabel(branch),
+		"{project}", SanitizeLabel(project),
+	)
+	prefix := strings.TrimSuffix(replacer.Replace(pattern), ".{domain}")
+
+	labels := strings.Split(prefix, ".")
+	for i, label := range labels {
+		labels[i] = ShortenLabel(strings.Trim(label, "-"))
+	}
+	return fmt.Sprintf("%s.%s", strings.Join(labels, "."), domain)
+}
+
+// DisambiguateHost appends a hash of key to the first label of host. It is
+// used when a formatted host is already taken by another service.
+func DisambiguateHost(host, key string) string {
+	label, rest, _ := strings.Cut(host, ".")
+	return fmt.Sprintf("%s.%s", ShortenLabel(fmt.Sprintf("%s-%s", label, HashSuffix(key))), rest)
+}
+
+// SanitizeLabel lowercases a value and replaces everything that is not
+// allowed in a DNS label with dashes.
+func SanitizeLabel(value string) string {
+	label := invalidLabelChar.ReplaceAllString(strings.ToLower(value), "-")
+	return strings.Trim(label, "-")
+}
+
+// ShortenLabel truncates labels longer than 63 characters and appends a hash
+// of the full label.
+func ShortenLabel(label string) string {
+	if len(label) <= maxLabelLength {
+		return label
+	}
+	return fmt.Sprintf("%s-%s",
+		strings.TrimRight(label[:maxLabelLength-hashLength-1], "-"), HashSuffix(label))
+}
+
+// HashSuffix returns a short, stable hash of value.
+func HashSuffix(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])[:hashLength]
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatHost(t *testing.T) {
+	longBranch := "feature/" + strings.Repeat("very-long-branch-name-", 5)
+
+	tests := []struct {
+		name    string
+		pattern string
+		branch  string
+		want    string
+	}{
+		{
+			name:    "simple branch",
+			pattern: "{service}-{branch}-{project}.{domain}",
+			branch:  "feature",
+			want:    "web-feature-shop.example.com",
+		},
+		{
+			name:    "branch is sanitized",
+			pattern: "{service}-{branch}-{project}.{domain}",
+			branch:  "Feature/Add_Login!",
+			want:    "web-feature-add-login-shop.example.com",
+		},
+		{
+			name:    "nested labels",
+			pattern: "{branch}.{service}.{project}.{domain}",
+			branch:  "feature",
+			want:    "feature.web.shop.example.com",
+		},
+		{
+			name:    "long branch is hashed",
+			pattern: "{service}-{branch}-{project}.{domain}",
+			branch:  longBranch,
+			want: ShortenLabel("web-"+SanitizeLabel(longBranch)+"-shop") +
+				".example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatHost(tt.pattern, "web", tt.branch, "shop", "example.com")
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+			for _, label := range strings.Split(got, ".") {
+				if len(label) > maxLabelLength {
+					t.Errorf("label %s is longer than %d characters", label, maxLabelLength)
+				}
+			}
+		})
+	}
+}
+
+func TestShortenLabelIsDistinct(t *testing.T) {
+	a := ShortenLabel(strings.Repeat("a", 70) + "-one")
+	b := ShortenLabel(strings.Repeat("a", 70) + "-two")
+	if a == b {
+		t.Errorf("expected distinct labels, got %s for both", a)
+	}
+	if len(a) > maxLabelLength {
+		t.Errorf("expected label of at most %d characters, got %d", maxLabelLength, len(a))
+	}
+}
+
+func TestValidateHostPattern(t *testing.T) {
+	valid := []string{
+		"{service}-{branch}-{project}.{domain}",
+		"{branch}.{project}.{domain}",
+	}
+	for _, pattern := range valid {
+		if err := ValidateHostPattern(pattern); err != nil {
+			t.Errorf("expected %s to be valid, got %v", pattern, err)
+		}
+	}
+
+	invalid := []string{
+		"{service}-{branch}",
+		"{service}-{user}.{domain}",
+		"{domain}.{domain}",
+	}
+	for _, pattern := range invalid {
+		if err := ValidateHostPattern(pattern); err == nil {
+			t.Errorf("expected %s to be invalid", pattern)
+		}
+	}
+}
@@ -9,9 +9,16 @@ import (
 
 	"nimbus/internal/database"
 	"nimbus/internal/env"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
-func FormatServiceURL(domain string, nodePort int32) string {
+// FormatServiceURL formats the address of a node port. Non-TCP ports are
+// prefixed with their protocol, e.g. udp://example.com:30053.
+func FormatServiceURL(domain string, nodePort int32, protocol corev1.Protocol) string {
+	if protocol != "" && protocol != corev1.ProtocolTCP {
+		return fmt.Sprintf("%s://%s:%d", strings.ToLower(string(protocol)), domain, nodePort)
+	}
 	return fmt.Sprintf("%s:%d", domain, nodePort)
 }
 